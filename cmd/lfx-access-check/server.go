@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"strings"
 	"sync"
 
 	"github.com/go-chi/chi/v5"
@@ -120,6 +121,23 @@ func handleHTTPServer(ctx context.Context, cfg *config.Config, endpoints *access
 	// Add middleware stack (with request ID first)
 	var handler http.Handler = mux
 	{
+		// Expose response headers to service methods (e.g. Retry-After)
+		handler = middleware.ResponseHeaderMiddleware()(handler)
+
+		// Bound each request by the caller's timeout header, capped by config
+		handler = middleware.RequestTimeoutMiddleware(cfg.RequestTimeoutMax)(handler)
+
+		// Shed excess load with 429 before it queues up behind fga-sync
+		if cfg.ConcurrencyLimitMax > 0 {
+			handler = middleware.ConcurrencyLimitMiddleware(middleware.ConcurrencyLimitConfig{
+				MinLimit:      cfg.ConcurrencyLimitMin,
+				MaxLimit:      cfg.ConcurrencyLimitMax,
+				TargetLatency: cfg.ConcurrencyTargetLatency,
+				QueueTimeout:  cfg.ConcurrencyQueueTimeout,
+				MaxQueue:      cfg.ConcurrencyMaxQueue,
+			}, isOperationalRequest)(handler)
+		}
+
 		// Add request ID middleware first
 		handler = middleware.RequestIDMiddleware()(handler)
 
@@ -150,6 +168,15 @@ func handleHTTPServer(ctx context.Context, cfg *config.Config, endpoints *access
 	return runServerWithContext(ctx, srv, cont)
 }
 
+// isOperationalRequest reports whether r is for a health or OpenAPI route,
+// which must keep answering while the service sheds load.
+func isOperationalRequest(r *http.Request) bool {
+	p := r.URL.Path
+	return p == accesssvcsvr.LivezAccessSvcPath() ||
+		p == accesssvcsvr.ReadyzAccessSvcPath() ||
+		strings.HasPrefix(p, constants.OpenAPIPathPrefix)
+}
+
 // errorHandler provides consistent error handling across all endpoints
 func errorHandler(logCtx context.Context) func(context.Context, http.ResponseWriter, error) {
 	return func(ctx context.Context, _ http.ResponseWriter, err error) {
@@ -5,6 +5,8 @@
 package design
 
 import (
+	"fmt"
+
 	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
 	. "goa.design/goa/v3/dsl"
 )
@@ -29,34 +31,40 @@ var _ = Service("access-svc", func() {
 
 		Payload(func() {
 			Token("bearer_token", String, "JWT token from Heimdall")
-			Attribute("version", String, "API version", func() {
-				Enum("1")
+			Attribute("version", String, "API version — v1 returns tuple-strings, v2 returns structured decisions", func() {
+				Enum("1", "2")
 				Example("1")
 			})
-			Attribute("requests", ArrayOf(String), "Resource-action pairs to check", func() {
+			Attribute("requests", ArrayOf(String), fmt.Sprintf("Resource-action pairs to check, each in strict 'type:id#relation' form; at most %d per call", constants.MaxCheckRequests), func() {
 				Example([]string{constants.ExampleProjectAction, constants.ExampleCommitteeAction})
 				MinLength(1)
+				MaxLength(constants.MaxCheckRequests)
+			})
+			Attribute("contextual_tuples", ArrayOf(ContextualTuple), "Tuples considered in addition to stored tuples for every check in this call; only accepted from allowed clients, and never for the checked user", func() {
+				MaxLength(constants.MaxContextualTuples)
+			})
+			Attribute("context", MapOf(String, Any), "Condition context for every check in this call, e.g. the current time or client IP; only accepted from allowed clients", func() {
+				Example(map[string]any{"current_time": "2026-01-01T00:00:00Z"})
 			})
 			Required("bearer_token", "version", "requests")
 		})
 
-		Result(func() {
-			Attribute("results", ArrayOf(String), "Access check results — each entry is 'object#relation@user\\ttrue' or 'object#relation@user\\tfalse'", func() {
-				Example([]string{
-					constants.ExampleProjectAction + "@user:auth0|alice\ttrue",
-					constants.ExampleCommitteeAction + "@user:auth0|alice\tfalse",
-				})
-			})
-			Required("results")
-		})
+		Result(CheckAccessResult)
 
 		Error("BadRequest", ErrorResult, "Bad request")
 		Error("Unauthorized", ErrorResult, "Unauthorized")
+		Error("Forbidden", ErrorResult, "Caller is not allowed to send contextual tuples")
 		Error("InternalServerError", ErrorResult, "Internal server error", func() { Fault() })
 		Error("ServiceUnavailable", ErrorResult, "Service unavailable", func() {
 			Temporary()
 			Fault()
 		})
+		Error("GatewayTimeout", ErrorResult, "The request deadline passed before the backend replied", func() {
+			Timeout()
+		})
+		Error("TooManyRequests", ErrorResult, "The caller exceeded its rate limit", func() {
+			Temporary()
+		})
 
 		HTTP(func() {
 			POST("/access-check")
@@ -65,13 +73,16 @@ var _ = Service("access-svc", func() {
 			Response(StatusOK)
 			Response("BadRequest", StatusBadRequest)
 			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
 			Response("InternalServerError", StatusInternalServerError)
 			Response("ServiceUnavailable", StatusServiceUnavailable)
+			Response("GatewayTimeout", StatusGatewayTimeout)
+			Response("TooManyRequests", StatusTooManyRequests)
 		})
 	})
 
 	Method("my-grants", func() {
-		Description("Get the caller's direct access grants for a given object type")
+		Description("Get the caller's direct access grants for one or more object types, optionally filtered by relation")
 		Security(JWTAuth)
 
 		Payload(func() {
@@ -80,18 +91,42 @@ var _ = Service("access-svc", func() {
 				Enum("1")
 				Example("1")
 			})
-			Attribute("object_type", String, "Object type to query grants for", func() {
-				Pattern(`^[a-z]+(_[a-z]+)*$`)
-				Example("project")
+			Attribute("object_type", ArrayOf(String, func() {
+				Pattern(constants.ObjectTypePattern)
+			}), "Object types to query grants for; repeat the parameter for several types", func() {
+				MinLength(1)
+				MaxLength(constants.MaxGrantsObjectTypes)
+				Example([]string{"project", "committee"})
+			})
+			Attribute("relation", String, "Only return grants of this relation", func() {
+				Pattern(constants.RelationPattern)
+				MaxLength(constants.MaxRelationLength)
+				Example("writer")
+			})
+			Attribute("page_size", Int, "Maximum number of grants to return", func() {
+				Minimum(1)
+				Maximum(constants.MaxGrantsPageSize)
+				Default(constants.DefaultGrantsPageSize)
+				Example(100)
+			})
+			Attribute("continuation_token", String, "Opaque token from a previous response to fetch the next page", func() {
+				MaxLength(constants.MaxContinuationTokenLength)
 			})
 			Required("bearer_token", "version", "object_type")
 		})
 
 		Result(func() {
-			Attribute("grants", ArrayOf(String), "Direct access grants as tuple-strings", func() {
+			Attribute("grants", ArrayOf(String), "Direct access grants as tuple-strings, grouped in requested object type order", func() {
 				Example([]string{"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#member@user:auth0|alice"})
 			})
-			Required("grants")
+			Attribute("grants_by_type", MapOf(String, ArrayOf(String)), "Direct access grants keyed by object type; every requested type is present", func() {
+				Example(map[string][]string{
+					"project":   {"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#member@user:auth0|alice"},
+					"committee": {},
+				})
+			})
+			Attribute("continuation_token", String, "Opaque token to fetch the next page; absent on the last page")
+			Required("grants", "grants_by_type")
 		})
 
 		Error("BadRequest", ErrorResult, "Bad request")
@@ -101,17 +136,209 @@ var _ = Service("access-svc", func() {
 			Temporary()
 			Fault()
 		})
+		Error("GatewayTimeout", ErrorResult, "The request deadline passed before the backend replied", func() {
+			Timeout()
+		})
+		Error("TooManyRequests", ErrorResult, "The caller exceeded its rate limit", func() {
+			Temporary()
+		})
 
 		HTTP(func() {
 			GET("/my-grants")
 			Param("version:v")
 			Param("object_type")
+			Param("relation")
+			Param("page_size")
+			Param("continuation_token")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+			Response("GatewayTimeout", StatusGatewayTimeout)
+			Response("TooManyRequests", StatusTooManyRequests)
+		})
+	})
+
+	Method("list-objects", func() {
+		Description("List every object of a type the caller holds a relation on, including access inherited through parent objects")
+		Security(JWTAuth)
+
+		Payload(func() {
+			Token("bearer_token", String, "JWT token from Heimdall")
+			Attribute("version", String, "API version", func() {
+				Enum("1")
+				Example("1")
+			})
+			Attribute("object_type", String, "Object type to list objects for", func() {
+				Pattern(constants.ObjectTypePattern)
+				Example("project")
+			})
+			Attribute("relation", String, "Relation the caller must hold on each object", func() {
+				Pattern(constants.RelationPattern)
+				MaxLength(constants.MaxRelationLength)
+				Example("viewer")
+			})
+			Required("bearer_token", "version", "object_type", "relation")
+		})
+
+		Result(func() {
+			Attribute("objects", ArrayOf(String), "Objects, in 'type:id' form, on which the caller holds the relation", func() {
+				Example([]string{"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f"})
+			})
+			Required("objects")
+		})
+
+		Error("BadRequest", ErrorResult, "Bad request")
+		Error("Unauthorized", ErrorResult, "Unauthorized")
+		Error("InternalServerError", ErrorResult, "Internal server error", func() { Fault() })
+		Error("ServiceUnavailable", ErrorResult, "Service unavailable", func() {
+			Temporary()
+			Fault()
+		})
+		Error("GatewayTimeout", ErrorResult, "The request deadline passed before the backend replied", func() {
+			Timeout()
+		})
+		Error("TooManyRequests", ErrorResult, "The caller exceeded its rate limit", func() {
+			Temporary()
+		})
+
+		HTTP(func() {
+			GET("/my-objects")
+			Param("version:v")
+			Param("object_type")
+			Param("relation")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+			Response("GatewayTimeout", StatusGatewayTimeout)
+			Response("TooManyRequests", StatusTooManyRequests)
+		})
+	})
+
+	Method("list-users", func() {
+		Description("List the users and usersets holding a relation on an object. The caller must itself hold the configured guard relation on the object")
+		Security(JWTAuth)
+
+		Payload(func() {
+			Token("bearer_token", String, "JWT token from Heimdall")
+			Attribute("version", String, "API version", func() {
+				Enum("1")
+				Example("1")
+			})
+			Attribute("object", String, "Object, in 'type:id' form, to list users for", func() {
+				Pattern(constants.ObjectPattern)
+				MaxLength(constants.MaxObjectLength)
+				Example("committee:b3c72e18-1a2b-4c3d-8e9f-123456789abc")
+			})
+			Attribute("relation", String, "Relation the listed users hold on the object", func() {
+				Pattern(constants.RelationPattern)
+				MaxLength(constants.MaxRelationLength)
+				Example("writer")
+			})
+			Required("bearer_token", "version", "object", "relation")
+		})
+
+		Result(func() {
+			Attribute("users", ArrayOf(String), "Users holding the relation", func() {
+				Example([]string{"user:auth0|alice"})
+			})
+			Attribute("usersets", ArrayOf(String), "Usersets holding the relation", func() {
+				Example([]string{"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#writer"})
+			})
+			Required("users", "usersets")
+		})
+
+		Error("BadRequest", ErrorResult, "Bad request")
+		Error("Unauthorized", ErrorResult, "Unauthorized")
+		Error("Forbidden", ErrorResult, "Caller does not hold the relation required to list users of the object")
+		Error("InternalServerError", ErrorResult, "Internal server error", func() { Fault() })
+		Error("ServiceUnavailable", ErrorResult, "Service unavailable", func() {
+			Temporary()
+			Fault()
+		})
+		Error("GatewayTimeout", ErrorResult, "The request deadline passed before the backend replied", func() {
+			Timeout()
+		})
+		Error("TooManyRequests", ErrorResult, "The caller exceeded its rate limit", func() {
+			Temporary()
+		})
+
+		HTTP(func() {
+			GET("/object-users")
+			Param("version:v")
+			Param("object")
+			Param("relation")
+			Header("bearer_token:Authorization")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+			Response("GatewayTimeout", StatusGatewayTimeout)
+			Response("TooManyRequests", StatusTooManyRequests)
+		})
+	})
+
+	Method("my-permissions", func() {
+		Description("List every relation the caller effectively holds on one object, as defined by the configured permission model")
+		Security(JWTAuth)
+
+		Payload(func() {
+			Token("bearer_token", String, "JWT token from Heimdall")
+			Attribute("version", String, "API version", func() {
+				Enum("1")
+				Example("1")
+			})
+			Attribute("object", String, "Object, in 'type:id' form, to list the caller's relations on", func() {
+				Pattern(constants.ObjectPattern)
+				MaxLength(constants.MaxObjectLength)
+				Example("project:a27394a3-7a6c-4d0f-9e0f-692d8753924f")
+			})
+			Required("bearer_token", "version", "object")
+		})
+
+		Result(func() {
+			Attribute("object", String, "Object the relations were checked on", func() {
+				Example("project:a27394a3-7a6c-4d0f-9e0f-692d8753924f")
+			})
+			Attribute("relations", ArrayOf(String), "Relations the caller holds on the object, in permission model order", func() {
+				Example([]string{"writer", "viewer"})
+			})
+			Required("object", "relations")
+		})
+
+		Error("BadRequest", ErrorResult, "Bad request")
+		Error("Unauthorized", ErrorResult, "Unauthorized")
+		Error("InternalServerError", ErrorResult, "Internal server error", func() { Fault() })
+		Error("ServiceUnavailable", ErrorResult, "Service unavailable", func() {
+			Temporary()
+			Fault()
+		})
+		Error("GatewayTimeout", ErrorResult, "The request deadline passed before the backend replied", func() {
+			Timeout()
+		})
+		Error("TooManyRequests", ErrorResult, "The caller exceeded its rate limit", func() {
+			Temporary()
+		})
+
+		HTTP(func() {
+			GET("/my-permissions")
+			Param("version:v")
+			Param("object")
 			Header("bearer_token:Authorization")
 			Response(StatusOK)
 			Response("BadRequest", StatusBadRequest)
 			Response("Unauthorized", StatusUnauthorized)
 			Response("InternalServerError", StatusInternalServerError)
 			Response("ServiceUnavailable", StatusServiceUnavailable)
+			Response("GatewayTimeout", StatusGatewayTimeout)
+			Response("TooManyRequests", StatusTooManyRequests)
 		})
 	})
 
@@ -5,6 +5,7 @@
 package design
 
 import (
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
 	. "goa.design/goa/v3/dsl"
 )
 
@@ -19,3 +20,70 @@ var AccessErrorResult = Type("AccessErrorResult", func() {
 	})
 	Required("message")
 })
+
+// CheckAccessResult defines the check-access response. The default view is
+// the v1 response, unchanged since before v2; the "v2" view carries
+// structured decisions instead.
+var CheckAccessResult = ResultType("application/vnd.lfx.check-access-result", "CheckAccessResult", func() {
+	Description("Access check results")
+	Attributes(func() {
+		Attribute("results", ArrayOf(String), "Access check results (v1) — each entry is 'object#relation@user\\ttrue' or 'object#relation@user\\tfalse'", func() {
+			Example([]string{
+				constants.ExampleProjectAction + "@user:auth0|alice\ttrue",
+				constants.ExampleCommitteeAction + "@user:auth0|alice\tfalse",
+			})
+		})
+		Attribute("decisions", ArrayOf(AccessCheckDecision), "Structured access check results (v2)")
+	})
+	View("default", func() {
+		Attribute("results")
+	})
+	View("v2", func() {
+		Attribute("decisions")
+	})
+	Required("results")
+})
+
+// AccessCheckDecision defines a single parsed access check result (API v2).
+var AccessCheckDecision = Type("AccessCheckDecision", func() {
+	Description("Parsed access check result for one object#relation@user tuple")
+	Attribute("object", String, "Object in type:id form", func() {
+		Example("project:a27394a3-7a6c-4d0f-9e0f-692d8753924f")
+	})
+	Attribute("relation", String, "Relation checked on the object", func() {
+		Example("auditor")
+	})
+	Attribute("user", String, "User the relation was checked for", func() {
+		Example("user:auth0|alice")
+	})
+	Attribute("allowed", Boolean, "Whether the user holds the relation on the object", func() {
+		Example(true)
+	})
+	Attribute("stale", Boolean, "Set when fga-sync was unavailable and the decision came from an expired cache entry or failed closed", func() {
+		Example(true)
+	})
+	Attribute("contextual", Boolean, "Set when the decision was made with the call's contextual tuples rather than stored tuples alone", func() {
+		Example(true)
+	})
+	Required("object", "relation", "user", "allowed")
+})
+
+// ContextualTuple defines a tuple that only exists for the duration of one
+// access check call.
+var ContextualTuple = Type("ContextualTuple", func() {
+	Description("Request-scoped relationship tuple considered in addition to stored tuples")
+	Attribute("object", String, "Object in type:id form", func() {
+		Pattern(constants.ObjectPattern)
+		MaxLength(constants.MaxObjectLength)
+		Example("project:a27394a3-7a6c-4d0f-9e0f-692d8753924f")
+	})
+	Attribute("relation", String, "Relation the user holds on the object", func() {
+		Pattern(constants.RelationPattern)
+		MaxLength(constants.MaxRelationLength)
+		Example("viewer")
+	})
+	Attribute("user", String, "Principal holding the relation; never the caller, a wildcard, a userset or an object", func() {
+		Example("user:auth0|bob")
+	})
+	Required("object", "relation", "user")
+})
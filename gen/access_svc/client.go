@@ -15,19 +15,25 @@ import (
 
 // Client is the "access-svc" service client.
 type Client struct {
-	CheckAccessEndpoint goa.Endpoint
-	MyGrantsEndpoint    goa.Endpoint
-	ReadyzEndpoint      goa.Endpoint
-	LivezEndpoint       goa.Endpoint
+	CheckAccessEndpoint   goa.Endpoint
+	MyGrantsEndpoint      goa.Endpoint
+	ListObjectsEndpoint   goa.Endpoint
+	ListUsersEndpoint     goa.Endpoint
+	MyPermissionsEndpoint goa.Endpoint
+	ReadyzEndpoint        goa.Endpoint
+	LivezEndpoint         goa.Endpoint
 }
 
 // NewClient initializes a "access-svc" service client given the endpoints.
-func NewClient(checkAccess, myGrants, readyz, livez goa.Endpoint) *Client {
+func NewClient(checkAccess, myGrants, listObjects, listUsers, myPermissions, readyz, livez goa.Endpoint) *Client {
 	return &Client{
-		CheckAccessEndpoint: checkAccess,
-		MyGrantsEndpoint:    myGrants,
-		ReadyzEndpoint:      readyz,
-		LivezEndpoint:       livez,
+		CheckAccessEndpoint:   checkAccess,
+		MyGrantsEndpoint:      myGrants,
+		ListObjectsEndpoint:   listObjects,
+		ListUsersEndpoint:     listUsers,
+		MyPermissionsEndpoint: myPermissions,
+		ReadyzEndpoint:        readyz,
+		LivezEndpoint:         livez,
 	}
 }
 
@@ -35,8 +41,11 @@ func NewClient(checkAccess, myGrants, readyz, livez goa.Endpoint) *Client {
 // CheckAccess may return the following errors:
 //   - "BadRequest" (type *goa.ServiceError): Bad request
 //   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "Forbidden" (type *goa.ServiceError): Caller is not allowed to send contextual tuples
 //   - "InternalServerError" (type *goa.ServiceError): Internal server error
 //   - "ServiceUnavailable" (type *goa.ServiceError): Service unavailable
+//   - "GatewayTimeout" (type *goa.ServiceError): The request deadline passed before the backend replied
+//   - "TooManyRequests" (type *goa.ServiceError): The caller exceeded its rate limit
 //   - error: internal error
 func (c *Client) CheckAccess(ctx context.Context, p *CheckAccessPayload) (res *CheckAccessResult, err error) {
 	var ires any
@@ -53,6 +62,8 @@ func (c *Client) CheckAccess(ctx context.Context, p *CheckAccessPayload) (res *C
 //   - "Unauthorized" (type *goa.ServiceError): Unauthorized
 //   - "InternalServerError" (type *goa.ServiceError): Internal server error
 //   - "ServiceUnavailable" (type *goa.ServiceError): Service unavailable
+//   - "GatewayTimeout" (type *goa.ServiceError): The request deadline passed before the backend replied
+//   - "TooManyRequests" (type *goa.ServiceError): The caller exceeded its rate limit
 //   - error: internal error
 func (c *Client) MyGrants(ctx context.Context, p *MyGrantsPayload) (res *MyGrantsResult, err error) {
 	var ires any
@@ -63,6 +74,62 @@ func (c *Client) MyGrants(ctx context.Context, p *MyGrantsPayload) (res *MyGrant
 	return ires.(*MyGrantsResult), nil
 }
 
+// ListObjects calls the "list-objects" endpoint of the "access-svc" service.
+// ListObjects may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "ServiceUnavailable" (type *goa.ServiceError): Service unavailable
+//   - "GatewayTimeout" (type *goa.ServiceError): The request deadline passed before the backend replied
+//   - "TooManyRequests" (type *goa.ServiceError): The caller exceeded its rate limit
+//   - error: internal error
+func (c *Client) ListObjects(ctx context.Context, p *ListObjectsPayload) (res *ListObjectsResult, err error) {
+	var ires any
+	ires, err = c.ListObjectsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListObjectsResult), nil
+}
+
+// ListUsers calls the "list-users" endpoint of the "access-svc" service.
+// ListUsers may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "Forbidden" (type *goa.ServiceError): Caller does not hold the relation required to list users of the object
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "ServiceUnavailable" (type *goa.ServiceError): Service unavailable
+//   - "GatewayTimeout" (type *goa.ServiceError): The request deadline passed before the backend replied
+//   - "TooManyRequests" (type *goa.ServiceError): The caller exceeded its rate limit
+//   - error: internal error
+func (c *Client) ListUsers(ctx context.Context, p *ListUsersPayload) (res *ListUsersResult, err error) {
+	var ires any
+	ires, err = c.ListUsersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListUsersResult), nil
+}
+
+// MyPermissions calls the "my-permissions" endpoint of the "access-svc"
+// service.
+// MyPermissions may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal server error
+//   - "ServiceUnavailable" (type *goa.ServiceError): Service unavailable
+//   - "GatewayTimeout" (type *goa.ServiceError): The request deadline passed before the backend replied
+//   - "TooManyRequests" (type *goa.ServiceError): The caller exceeded its rate limit
+//   - error: internal error
+func (c *Client) MyPermissions(ctx context.Context, p *MyPermissionsPayload) (res *MyPermissionsResult, err error) {
+	var ires any
+	ires, err = c.MyPermissionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*MyPermissionsResult), nil
+}
+
 // Readyz calls the "readyz" endpoint of the "access-svc" service.
 // Readyz may return the following errors:
 //   - "NotReady" (type *goa.ServiceError): Service not ready
@@ -16,10 +16,13 @@ import (
 
 // Endpoints wraps the "access-svc" service endpoints.
 type Endpoints struct {
-	CheckAccess goa.Endpoint
-	MyGrants    goa.Endpoint
-	Readyz      goa.Endpoint
-	Livez       goa.Endpoint
+	CheckAccess   goa.Endpoint
+	MyGrants      goa.Endpoint
+	ListObjects   goa.Endpoint
+	ListUsers     goa.Endpoint
+	MyPermissions goa.Endpoint
+	Readyz        goa.Endpoint
+	Livez         goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "access-svc" service with endpoints.
@@ -27,10 +30,13 @@ func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
 	a := s.(Auther)
 	return &Endpoints{
-		CheckAccess: NewCheckAccessEndpoint(s, a.JWTAuth),
-		MyGrants:    NewMyGrantsEndpoint(s, a.JWTAuth),
-		Readyz:      NewReadyzEndpoint(s),
-		Livez:       NewLivezEndpoint(s),
+		CheckAccess:   NewCheckAccessEndpoint(s, a.JWTAuth),
+		MyGrants:      NewMyGrantsEndpoint(s, a.JWTAuth),
+		ListObjects:   NewListObjectsEndpoint(s, a.JWTAuth),
+		ListUsers:     NewListUsersEndpoint(s, a.JWTAuth),
+		MyPermissions: NewMyPermissionsEndpoint(s, a.JWTAuth),
+		Readyz:        NewReadyzEndpoint(s),
+		Livez:         NewLivezEndpoint(s),
 	}
 }
 
@@ -38,6 +44,9 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.CheckAccess = m(e.CheckAccess)
 	e.MyGrants = m(e.MyGrants)
+	e.ListObjects = m(e.ListObjects)
+	e.ListUsers = m(e.ListUsers)
+	e.MyPermissions = m(e.MyPermissions)
 	e.Readyz = m(e.Readyz)
 	e.Livez = m(e.Livez)
 }
@@ -57,7 +66,12 @@ func NewCheckAccessEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpo
 		if err != nil {
 			return nil, err
 		}
-		return s.CheckAccess(ctx, p)
+		res, view, err := s.CheckAccess(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedCheckAccessResult(res, view)
+		return vres, nil
 	}
 }
 
@@ -80,6 +94,63 @@ func NewMyGrantsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint
 	}
 }
 
+// NewListObjectsEndpoint returns an endpoint function that calls the method
+// "list-objects" of service "access-svc".
+func NewListObjectsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListObjectsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.BearerToken, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListObjects(ctx, p)
+	}
+}
+
+// NewListUsersEndpoint returns an endpoint function that calls the method
+// "list-users" of service "access-svc".
+func NewListUsersEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListUsersPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.BearerToken, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListUsers(ctx, p)
+	}
+}
+
+// NewMyPermissionsEndpoint returns an endpoint function that calls the method
+// "my-permissions" of service "access-svc".
+func NewMyPermissionsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*MyPermissionsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authJWTFn(ctx, p.BearerToken, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.MyPermissions(ctx, p)
+	}
+}
+
 // NewReadyzEndpoint returns an endpoint function that calls the method
 // "readyz" of service "access-svc".
 func NewReadyzEndpoint(s Service) goa.Endpoint {
@@ -10,6 +10,7 @@ package accesssvc
 import (
 	"context"
 
+	accesssvcviews "github.com/linuxfoundation/lfx-v2-access-check/gen/access_svc/views"
 	goa "goa.design/goa/v3/pkg"
 	"goa.design/goa/v3/security"
 )
@@ -17,9 +18,22 @@ import (
 // LFX Access Check Service
 type Service interface {
 	// Check access permissions for resource-action pairs
-	CheckAccess(context.Context, *CheckAccessPayload) (res *CheckAccessResult, err error)
-	// Get the caller's direct access grants for a given object type
+	// The "view" return value must have one of the following views
+	//	- "default"
+	//	- "v2"
+	CheckAccess(context.Context, *CheckAccessPayload) (res *CheckAccessResult, view string, err error)
+	// Get the caller's direct access grants for one or more object types,
+	// optionally filtered by relation
 	MyGrants(context.Context, *MyGrantsPayload) (res *MyGrantsResult, err error)
+	// List every object of a type the caller holds a relation on, including access
+	// inherited through parent objects
+	ListObjects(context.Context, *ListObjectsPayload) (res *ListObjectsResult, err error)
+	// List the users and usersets holding a relation on an object. The caller must
+	// itself hold the configured guard relation on the object
+	ListUsers(context.Context, *ListUsersPayload) (res *ListUsersResult, err error)
+	// List every relation the caller effectively holds on one object, as defined
+	// by the configured permission model
+	MyPermissions(context.Context, *MyPermissionsPayload) (res *MyPermissionsResult, err error)
 	// Check if service is ready
 	Readyz(context.Context) (res []byte, err error)
 	// Check if service is alive
@@ -46,25 +60,105 @@ const ServiceName = "access-svc"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [4]string{"check-access", "my-grants", "readyz", "livez"}
+var MethodNames = [7]string{"check-access", "my-grants", "list-objects", "list-users", "my-permissions", "readyz", "livez"}
+
+// Parsed access check result for one object#relation@user tuple
+type AccessCheckDecision struct {
+	// Object in type:id form
+	Object string
+	// Relation checked on the object
+	Relation string
+	// User the relation was checked for
+	User string
+	// Whether the user holds the relation on the object
+	Allowed bool
+	// Set when fga-sync was unavailable and the decision came from an expired
+	// cache entry or failed closed
+	Stale *bool
+	// Set when the decision was made with the call's contextual tuples rather than
+	// stored tuples alone
+	Contextual *bool
+}
 
 // CheckAccessPayload is the payload type of the access-svc service
 // check-access method.
 type CheckAccessPayload struct {
 	// JWT token from Heimdall
 	BearerToken string
-	// API version
+	// API version — v1 returns tuple-strings, v2 returns structured decisions
 	Version string
-	// Resource-action pairs to check
+	// Resource-action pairs to check, each in strict 'type:id#relation' form; at
+	// most 1000 per call
 	Requests []string
+	// Tuples considered in addition to stored tuples for every check in this call;
+	// only accepted from allowed clients, and never for the checked user
+	ContextualTuples []*ContextualTuple
+	// Condition context for every check in this call, e.g. the current time or
+	// client IP; only accepted from allowed clients
+	Context map[string]any
 }
 
 // CheckAccessResult is the result type of the access-svc service check-access
 // method.
 type CheckAccessResult struct {
-	// Access check results — each entry is 'object#relation@user\ttrue' or
+	// Access check results (v1) — each entry is 'object#relation@user\ttrue' or
 	// 'object#relation@user\tfalse'
 	Results []string
+	// Structured access check results (v2)
+	Decisions []*AccessCheckDecision
+}
+
+// Request-scoped relationship tuple considered in addition to stored tuples
+type ContextualTuple struct {
+	// Object in type:id form
+	Object string
+	// Relation the user holds on the object
+	Relation string
+	// Principal holding the relation; never the caller, a wildcard, a userset or
+	// an object
+	User string
+}
+
+// ListObjectsPayload is the payload type of the access-svc service
+// list-objects method.
+type ListObjectsPayload struct {
+	// JWT token from Heimdall
+	BearerToken string
+	// API version
+	Version string
+	// Object type to list objects for
+	ObjectType string
+	// Relation the caller must hold on each object
+	Relation string
+}
+
+// ListObjectsResult is the result type of the access-svc service list-objects
+// method.
+type ListObjectsResult struct {
+	// Objects, in 'type:id' form, on which the caller holds the relation
+	Objects []string
+}
+
+// ListUsersPayload is the payload type of the access-svc service list-users
+// method.
+type ListUsersPayload struct {
+	// JWT token from Heimdall
+	BearerToken string
+	// API version
+	Version string
+	// Object, in 'type:id' form, to list users for
+	Object string
+	// Relation the listed users hold on the object
+	Relation string
+}
+
+// ListUsersResult is the result type of the access-svc service list-users
+// method.
+type ListUsersResult struct {
+	// Users holding the relation
+	Users []string
+	// Usersets holding the relation
+	Usersets []string
 }
 
 // MyGrantsPayload is the payload type of the access-svc service my-grants
@@ -74,14 +168,44 @@ type MyGrantsPayload struct {
 	BearerToken string
 	// API version
 	Version string
-	// Object type to query grants for
-	ObjectType string
+	// Object types to query grants for; repeat the parameter for several types
+	ObjectType []string
+	// Only return grants of this relation
+	Relation *string
+	// Maximum number of grants to return
+	PageSize int
+	// Opaque token from a previous response to fetch the next page
+	ContinuationToken *string
 }
 
 // MyGrantsResult is the result type of the access-svc service my-grants method.
 type MyGrantsResult struct {
-	// Direct access grants as tuple-strings
+	// Direct access grants as tuple-strings, grouped in requested object type order
 	Grants []string
+	// Direct access grants keyed by object type; every requested type is present
+	GrantsByType map[string][]string
+	// Opaque token to fetch the next page; absent on the last page
+	ContinuationToken *string
+}
+
+// MyPermissionsPayload is the payload type of the access-svc service
+// my-permissions method.
+type MyPermissionsPayload struct {
+	// JWT token from Heimdall
+	BearerToken string
+	// API version
+	Version string
+	// Object, in 'type:id' form, to list the caller's relations on
+	Object string
+}
+
+// MyPermissionsResult is the result type of the access-svc service
+// my-permissions method.
+type MyPermissionsResult struct {
+	// Object the relations were checked on
+	Object string
+	// Relations the caller holds on the object, in permission model order
+	Relations []string
 }
 
 // MakeBadRequest builds a goa.ServiceError from an error.
@@ -94,6 +218,11 @@ func MakeUnauthorized(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "Unauthorized", false, false, false)
 }
 
+// MakeForbidden builds a goa.ServiceError from an error.
+func MakeForbidden(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "Forbidden", false, false, false)
+}
+
 // MakeInternalServerError builds a goa.ServiceError from an error.
 func MakeInternalServerError(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "InternalServerError", false, false, true)
@@ -104,7 +233,145 @@ func MakeServiceUnavailable(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "ServiceUnavailable", false, true, true)
 }
 
+// MakeGatewayTimeout builds a goa.ServiceError from an error.
+func MakeGatewayTimeout(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "GatewayTimeout", true, false, false)
+}
+
+// MakeTooManyRequests builds a goa.ServiceError from an error.
+func MakeTooManyRequests(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "TooManyRequests", false, true, false)
+}
+
 // MakeNotReady builds a goa.ServiceError from an error.
 func MakeNotReady(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "NotReady", false, true, true)
 }
+
+// NewCheckAccessResult initializes result type CheckAccessResult from viewed
+// result type CheckAccessResult.
+func NewCheckAccessResult(vres *accesssvcviews.CheckAccessResult) *CheckAccessResult {
+	var res *CheckAccessResult
+	switch vres.View {
+	case "default", "":
+		res = newCheckAccessResult(vres.Projected)
+	case "v2":
+		res = newCheckAccessResultV2(vres.Projected)
+	}
+	return res
+}
+
+// NewViewedCheckAccessResult initializes viewed result type CheckAccessResult
+// from result type CheckAccessResult using the given view.
+func NewViewedCheckAccessResult(res *CheckAccessResult, view string) *accesssvcviews.CheckAccessResult {
+	var vres *accesssvcviews.CheckAccessResult
+	switch view {
+	case "default", "":
+		p := newCheckAccessResultView(res)
+		vres = &accesssvcviews.CheckAccessResult{Projected: p, View: "default"}
+	case "v2":
+		p := newCheckAccessResultViewV2(res)
+		vres = &accesssvcviews.CheckAccessResult{Projected: p, View: "v2"}
+	}
+	return vres
+}
+
+// newCheckAccessResult converts projected type CheckAccessResult to service
+// type CheckAccessResult.
+func newCheckAccessResult(vres *accesssvcviews.CheckAccessResultView) *CheckAccessResult {
+	res := &CheckAccessResult{}
+	if vres.Results != nil {
+		res.Results = make([]string, len(vres.Results))
+		for i, val := range vres.Results {
+			res.Results[i] = val
+		}
+	}
+	return res
+}
+
+// newCheckAccessResultV2 converts projected type CheckAccessResult to service
+// type CheckAccessResult.
+func newCheckAccessResultV2(vres *accesssvcviews.CheckAccessResultView) *CheckAccessResult {
+	res := &CheckAccessResult{}
+	if vres.Decisions != nil {
+		res.Decisions = make([]*AccessCheckDecision, len(vres.Decisions))
+		for i, val := range vres.Decisions {
+			if val == nil {
+				res.Decisions[i] = nil
+				continue
+			}
+			res.Decisions[i] = transformAccesssvcviewsAccessCheckDecisionViewToAccessCheckDecision(val)
+		}
+	}
+	return res
+}
+
+// newCheckAccessResultView projects result type CheckAccessResult to projected
+// type CheckAccessResultView using the "default" view.
+func newCheckAccessResultView(res *CheckAccessResult) *accesssvcviews.CheckAccessResultView {
+	vres := &accesssvcviews.CheckAccessResultView{}
+	if res.Results != nil {
+		vres.Results = make([]string, len(res.Results))
+		for i, val := range res.Results {
+			vres.Results[i] = val
+		}
+	} else {
+		vres.Results = []string{}
+	}
+	return vres
+}
+
+// newCheckAccessResultViewV2 projects result type CheckAccessResult to
+// projected type CheckAccessResultView using the "v2" view.
+func newCheckAccessResultViewV2(res *CheckAccessResult) *accesssvcviews.CheckAccessResultView {
+	vres := &accesssvcviews.CheckAccessResultView{}
+	if res.Decisions != nil {
+		vres.Decisions = make([]*accesssvcviews.AccessCheckDecisionView, len(res.Decisions))
+		for i, val := range res.Decisions {
+			if val == nil {
+				vres.Decisions[i] = nil
+				continue
+			}
+			vres.Decisions[i] = transformAccessCheckDecisionToAccesssvcviewsAccessCheckDecisionView(val)
+		}
+	}
+	return vres
+}
+
+// transformAccesssvcviewsAccessCheckDecisionViewToAccessCheckDecision builds a
+// value of type *AccessCheckDecision from a value of type
+// *accesssvcviews.AccessCheckDecisionView.
+func transformAccesssvcviewsAccessCheckDecisionViewToAccessCheckDecision(v *accesssvcviews.AccessCheckDecisionView) *AccessCheckDecision {
+	if v == nil {
+		return nil
+	}
+	res := &AccessCheckDecision{
+		Object:     *v.Object,
+		Relation:   *v.Relation,
+		User:       *v.User,
+		Allowed:    *v.Allowed,
+		Stale:      v.Stale,
+		Contextual: v.Contextual,
+	}
+
+	return res
+}
+
+// transformAccessCheckDecisionToAccesssvcviewsAccessCheckDecisionView builds a
+// value of type *accesssvcviews.AccessCheckDecisionView from a value of type
+// *AccessCheckDecision.
+func transformAccessCheckDecisionToAccesssvcviewsAccessCheckDecisionView(v *AccessCheckDecision) *accesssvcviews.AccessCheckDecisionView {
+	if v == nil {
+		return nil
+	}
+	res := &accesssvcviews.AccessCheckDecisionView{
+		Object:     &v.Object,
+		Relation:   &v.Relation,
+		User:       &v.User,
+		Allowed:    &v.Allowed,
+		Stale:      v.Stale,
+		Contextual: v.Contextual,
+	}
+
+	return res
+}
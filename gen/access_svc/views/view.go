@@ -0,0 +1,115 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// access-svc views
+//
+// Command:
+// $ goa gen github.com/linuxfoundation/lfx-v2-access-check/design
+
+package views
+
+import (
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CheckAccessResult is the viewed result type that is projected based on a
+// view.
+type CheckAccessResult struct {
+	// Type to project
+	Projected *CheckAccessResultView
+	// View to render
+	View string
+}
+
+// CheckAccessResultView is a type that runs validations on a projected type.
+type CheckAccessResultView struct {
+	// Access check results (v1) — each entry is 'object#relation@user\ttrue' or
+	// 'object#relation@user\tfalse'
+	Results []string
+	// Structured access check results (v2)
+	Decisions []*AccessCheckDecisionView
+}
+
+// AccessCheckDecisionView is a type that runs validations on a projected type.
+type AccessCheckDecisionView struct {
+	// Object in type:id form
+	Object *string
+	// Relation checked on the object
+	Relation *string
+	// User the relation was checked for
+	User *string
+	// Whether the user holds the relation on the object
+	Allowed *bool
+	// Set when fga-sync was unavailable and the decision came from an expired
+	// cache entry or failed closed
+	Stale *bool
+	// Set when the decision was made with the call's contextual tuples rather than
+	// stored tuples alone
+	Contextual *bool
+}
+
+var (
+	// CheckAccessResultMap is a map indexing the attribute names of
+	// CheckAccessResult by view name.
+	CheckAccessResultMap = map[string][]string{
+		"default": {
+			"results",
+		},
+		"v2": {
+			"decisions",
+		},
+	}
+)
+
+// ValidateCheckAccessResult runs the validations defined on the viewed result
+// type CheckAccessResult.
+func ValidateCheckAccessResult(result *CheckAccessResult) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateCheckAccessResultView(result.Projected)
+	case "v2":
+		err = ValidateCheckAccessResultViewV2(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default", "v2"})
+	}
+	return
+}
+
+// ValidateCheckAccessResultView runs the validations defined on
+// CheckAccessResultView using the "default" view.
+func ValidateCheckAccessResultView(result *CheckAccessResultView) (err error) {
+	if result.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "result"))
+	}
+	return
+}
+
+// ValidateCheckAccessResultViewV2 runs the validations defined on
+// CheckAccessResultView using the "v2" view.
+func ValidateCheckAccessResultViewV2(result *CheckAccessResultView) (err error) {
+	for _, e := range result.Decisions {
+		if e != nil {
+			if err2 := ValidateAccessCheckDecisionView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAccessCheckDecisionView runs the validations defined on
+// AccessCheckDecisionView.
+func ValidateAccessCheckDecisionView(result *AccessCheckDecisionView) (err error) {
+	if result.Object == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("object", "result"))
+	}
+	if result.Relation == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("relation", "result"))
+	}
+	if result.User == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user", "result"))
+	}
+	if result.Allowed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("allowed", "result"))
+	}
+	return
+}
@@ -10,6 +10,8 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"unicode/utf8"
 
 	accesssvc "github.com/linuxfoundation/lfx-v2-access-check/gen/access_svc"
 	goa "goa.design/goa/v3/pkg"
@@ -23,7 +25,7 @@ func BuildCheckAccessPayload(accessSvcCheckAccessBody string, accessSvcCheckAcce
 	{
 		err = json.Unmarshal([]byte(accessSvcCheckAccessBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"requests\": [\n         \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#auditor\",\n         \"committee:b3c72e18-1a2b-4c3d-8e9f-123456789abc#writer\"\n      ]\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"context\": {\n         \"current_time\": \"2026-01-01T00:00:00Z\"\n      },\n      \"contextual_tuples\": [\n         {\n            \"object\": \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f\",\n            \"relation\": \"viewer\",\n            \"user\": \"user:auth0|bob\"\n         },\n         {\n            \"object\": \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f\",\n            \"relation\": \"viewer\",\n            \"user\": \"user:auth0|bob\"\n         },\n         {\n            \"object\": \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f\",\n            \"relation\": \"viewer\",\n            \"user\": \"user:auth0|bob\"\n         }\n      ],\n      \"requests\": [\n         \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#auditor\",\n         \"committee:b3c72e18-1a2b-4c3d-8e9f-123456789abc#writer\"\n      ]\n   }'")
 		}
 		if body.Requests == nil {
 			err = goa.MergeErrors(err, goa.MissingFieldError("requests", "body"))
@@ -31,6 +33,19 @@ func BuildCheckAccessPayload(accessSvcCheckAccessBody string, accessSvcCheckAcce
 		if len(body.Requests) < 1 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.requests", body.Requests, len(body.Requests), 1, true))
 		}
+		if len(body.Requests) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.requests", body.Requests, len(body.Requests), 1000, false))
+		}
+		if len(body.ContextualTuples) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.contextual_tuples", body.ContextualTuples, len(body.ContextualTuples), 100, false))
+		}
+		for _, e := range body.ContextualTuples {
+			if e != nil {
+				if err2 := ValidateContextualTupleRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -38,8 +53,8 @@ func BuildCheckAccessPayload(accessSvcCheckAccessBody string, accessSvcCheckAcce
 	var version string
 	{
 		version = accessSvcCheckAccessVersion
-		if !(version == "1") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		if !(version == "1" || version == "2") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1", "2"}))
 		}
 		if err != nil {
 			return nil, err
@@ -58,6 +73,24 @@ func BuildCheckAccessPayload(accessSvcCheckAccessBody string, accessSvcCheckAcce
 	} else {
 		v.Requests = []string{}
 	}
+	if body.ContextualTuples != nil {
+		v.ContextualTuples = make([]*accesssvc.ContextualTuple, len(body.ContextualTuples))
+		for i, val := range body.ContextualTuples {
+			if val == nil {
+				v.ContextualTuples[i] = nil
+				continue
+			}
+			v.ContextualTuples[i] = marshalContextualTupleRequestBodyToAccesssvcContextualTuple(val)
+		}
+	}
+	if body.Context != nil {
+		v.Context = make(map[string]any, len(body.Context))
+		for key, val := range body.Context {
+			tk := key
+			tv := val
+			v.Context[tk] = tv
+		}
+	}
 	v.Version = version
 	v.BearerToken = bearerToken
 
@@ -66,7 +99,7 @@ func BuildCheckAccessPayload(accessSvcCheckAccessBody string, accessSvcCheckAcce
 
 // BuildMyGrantsPayload builds the payload for the access-svc my-grants
 // endpoint from CLI flags.
-func BuildMyGrantsPayload(accessSvcMyGrantsVersion string, accessSvcMyGrantsObjectType string, accessSvcMyGrantsBearerToken string) (*accesssvc.MyGrantsPayload, error) {
+func BuildMyGrantsPayload(accessSvcMyGrantsVersion string, accessSvcMyGrantsObjectType string, accessSvcMyGrantsRelation string, accessSvcMyGrantsPageSize string, accessSvcMyGrantsContinuationToken string, accessSvcMyGrantsBearerToken string) (*accesssvc.MyGrantsPayload, error) {
 	var err error
 	var version string
 	{
@@ -78,14 +111,70 @@ func BuildMyGrantsPayload(accessSvcMyGrantsVersion string, accessSvcMyGrantsObje
 			return nil, err
 		}
 	}
-	var objectType string
+	var objectType []string
 	{
-		objectType = accessSvcMyGrantsObjectType
-		err = goa.MergeErrors(err, goa.ValidatePattern("object_type", objectType, "^[a-z]+(_[a-z]+)*$"))
+		err = json.Unmarshal([]byte(accessSvcMyGrantsObjectType), &objectType)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for objectType, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"project\",\n      \"committee\"\n   ]'")
+		}
+		if len(objectType) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("object_type", objectType, len(objectType), 1, true))
+		}
+		if len(objectType) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("object_type", objectType, len(objectType), 20, false))
+		}
+		for _, e := range objectType {
+			err = goa.MergeErrors(err, goa.ValidatePattern("object_type[*]", e, "^[a-z][a-z0-9_]*$"))
+		}
 		if err != nil {
 			return nil, err
 		}
 	}
+	var relation *string
+	{
+		if accessSvcMyGrantsRelation != "" {
+			relation = &accessSvcMyGrantsRelation
+			err = goa.MergeErrors(err, goa.ValidatePattern("relation", *relation, "^[a-z][a-z0-9_]*$"))
+			if utf8.RuneCountInString(*relation) > 50 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("relation", *relation, utf8.RuneCountInString(*relation), 50, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var pageSize int
+	{
+		if accessSvcMyGrantsPageSize != "" {
+			var v int64
+			v, err = strconv.ParseInt(accessSvcMyGrantsPageSize, 10, strconv.IntSize)
+			pageSize = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for pageSize, must be INT")
+			}
+			if pageSize < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+			}
+			if pageSize > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var continuationToken *string
+	{
+		if accessSvcMyGrantsContinuationToken != "" {
+			continuationToken = &accessSvcMyGrantsContinuationToken
+			if utf8.RuneCountInString(*continuationToken) > 1024 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("continuation_token", *continuationToken, utf8.RuneCountInString(*continuationToken), 1024, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	var bearerToken string
 	{
 		bearerToken = accessSvcMyGrantsBearerToken
@@ -93,6 +182,141 @@ func BuildMyGrantsPayload(accessSvcMyGrantsVersion string, accessSvcMyGrantsObje
 	v := &accesssvc.MyGrantsPayload{}
 	v.Version = version
 	v.ObjectType = objectType
+	v.Relation = relation
+	v.PageSize = pageSize
+	v.ContinuationToken = continuationToken
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListObjectsPayload builds the payload for the access-svc list-objects
+// endpoint from CLI flags.
+func BuildListObjectsPayload(accessSvcListObjectsVersion string, accessSvcListObjectsObjectType string, accessSvcListObjectsRelation string, accessSvcListObjectsBearerToken string) (*accesssvc.ListObjectsPayload, error) {
+	var err error
+	var version string
+	{
+		version = accessSvcListObjectsVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var objectType string
+	{
+		objectType = accessSvcListObjectsObjectType
+		err = goa.MergeErrors(err, goa.ValidatePattern("object_type", objectType, "^[a-z][a-z0-9_]*$"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var relation string
+	{
+		relation = accessSvcListObjectsRelation
+		err = goa.MergeErrors(err, goa.ValidatePattern("relation", relation, "^[a-z][a-z0-9_]*$"))
+		if utf8.RuneCountInString(relation) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("relation", relation, utf8.RuneCountInString(relation), 50, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken string
+	{
+		bearerToken = accessSvcListObjectsBearerToken
+	}
+	v := &accesssvc.ListObjectsPayload{}
+	v.Version = version
+	v.ObjectType = objectType
+	v.Relation = relation
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildListUsersPayload builds the payload for the access-svc list-users
+// endpoint from CLI flags.
+func BuildListUsersPayload(accessSvcListUsersVersion string, accessSvcListUsersObject string, accessSvcListUsersRelation string, accessSvcListUsersBearerToken string) (*accesssvc.ListUsersPayload, error) {
+	var err error
+	var version string
+	{
+		version = accessSvcListUsersVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var object string
+	{
+		object = accessSvcListUsersObject
+		err = goa.MergeErrors(err, goa.ValidatePattern("object", object, "^[a-z][a-z0-9_]*:[A-Za-z0-9][A-Za-z0-9._~|+=-]*$"))
+		if utf8.RuneCountInString(object) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("object", object, utf8.RuneCountInString(object), 256, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var relation string
+	{
+		relation = accessSvcListUsersRelation
+		err = goa.MergeErrors(err, goa.ValidatePattern("relation", relation, "^[a-z][a-z0-9_]*$"))
+		if utf8.RuneCountInString(relation) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("relation", relation, utf8.RuneCountInString(relation), 50, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken string
+	{
+		bearerToken = accessSvcListUsersBearerToken
+	}
+	v := &accesssvc.ListUsersPayload{}
+	v.Version = version
+	v.Object = object
+	v.Relation = relation
+	v.BearerToken = bearerToken
+
+	return v, nil
+}
+
+// BuildMyPermissionsPayload builds the payload for the access-svc
+// my-permissions endpoint from CLI flags.
+func BuildMyPermissionsPayload(accessSvcMyPermissionsVersion string, accessSvcMyPermissionsObject string, accessSvcMyPermissionsBearerToken string) (*accesssvc.MyPermissionsPayload, error) {
+	var err error
+	var version string
+	{
+		version = accessSvcMyPermissionsVersion
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var object string
+	{
+		object = accessSvcMyPermissionsObject
+		err = goa.MergeErrors(err, goa.ValidatePattern("object", object, "^[a-z][a-z0-9_]*:[A-Za-z0-9][A-Za-z0-9._~|+=-]*$"))
+		if utf8.RuneCountInString(object) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("object", object, utf8.RuneCountInString(object), 256, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bearerToken string
+	{
+		bearerToken = accessSvcMyPermissionsBearerToken
+	}
+	v := &accesssvc.MyPermissionsPayload{}
+	v.Version = version
+	v.Object = object
 	v.BearerToken = bearerToken
 
 	return v, nil
@@ -25,6 +25,18 @@ type Client struct {
 	// endpoint.
 	MyGrantsDoer goahttp.Doer
 
+	// ListObjects Doer is the HTTP client used to make requests to the
+	// list-objects endpoint.
+	ListObjectsDoer goahttp.Doer
+
+	// ListUsers Doer is the HTTP client used to make requests to the list-users
+	// endpoint.
+	ListUsersDoer goahttp.Doer
+
+	// MyPermissions Doer is the HTTP client used to make requests to the
+	// my-permissions endpoint.
+	MyPermissionsDoer goahttp.Doer
+
 	// Readyz Doer is the HTTP client used to make requests to the readyz endpoint.
 	ReadyzDoer goahttp.Doer
 
@@ -53,6 +65,9 @@ func NewClient(
 	return &Client{
 		CheckAccessDoer:     doer,
 		MyGrantsDoer:        doer,
+		ListObjectsDoer:     doer,
+		ListUsersDoer:       doer,
+		MyPermissionsDoer:   doer,
 		ReadyzDoer:          doer,
 		LivezDoer:           doer,
 		RestoreResponseBody: restoreBody,
@@ -111,6 +126,78 @@ func (c *Client) MyGrants() goa.Endpoint {
 	}
 }
 
+// ListObjects returns an endpoint that makes HTTP requests to the access-svc
+// service list-objects server.
+func (c *Client) ListObjects() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListObjectsRequest(c.encoder)
+		decodeResponse = DecodeListObjectsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListObjectsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListObjectsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("access-svc", "list-objects", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListUsers returns an endpoint that makes HTTP requests to the access-svc
+// service list-users server.
+func (c *Client) ListUsers() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListUsersRequest(c.encoder)
+		decodeResponse = DecodeListUsersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListUsersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListUsersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("access-svc", "list-users", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// MyPermissions returns an endpoint that makes HTTP requests to the access-svc
+// service my-permissions server.
+func (c *Client) MyPermissions() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeMyPermissionsRequest(c.encoder)
+		decodeResponse = DecodeMyPermissionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildMyPermissionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.MyPermissionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("access-svc", "my-permissions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // Readyz returns an endpoint that makes HTTP requests to the access-svc
 // service readyz server.
 func (c *Client) Readyz() goa.Endpoint {
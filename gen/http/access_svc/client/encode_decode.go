@@ -10,12 +10,14 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
 
 	accesssvc "github.com/linuxfoundation/lfx-v2-access-check/gen/access_svc"
+	accesssvcviews "github.com/linuxfoundation/lfx-v2-access-check/gen/access_svc/views"
 	goahttp "goa.design/goa/v3/http"
 )
 
@@ -67,8 +69,11 @@ func EncodeCheckAccessRequest(encoder func(*http.Request) goahttp.Encoder) func(
 // DecodeCheckAccessResponse may return the following errors:
 //   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
 //   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "Forbidden" (type *goa.ServiceError): http.StatusForbidden
 //   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
 //   - "ServiceUnavailable" (type *goa.ServiceError): http.StatusServiceUnavailable
+//   - "GatewayTimeout" (type *goa.ServiceError): http.StatusGatewayTimeout
+//   - "TooManyRequests" (type *goa.ServiceError): http.StatusTooManyRequests
 //   - error: internal error
 func DecodeCheckAccessResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -94,11 +99,13 @@ func DecodeCheckAccessResponse(decoder func(*http.Response) goahttp.Decoder, res
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("access-svc", "check-access", err)
 			}
-			err = ValidateCheckAccessResponseBody(&body)
-			if err != nil {
+			p := NewCheckAccessResultViewOK(&body)
+			view := resp.Header.Get("goa-view")
+			vres := &accesssvcviews.CheckAccessResult{Projected: p, View: view}
+			if err = accesssvcviews.ValidateCheckAccessResult(vres); err != nil {
 				return nil, goahttp.ErrValidationError("access-svc", "check-access", err)
 			}
-			res := NewCheckAccessResultOK(&body)
+			res := accesssvc.NewCheckAccessResult(vres)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
@@ -128,6 +135,20 @@ func DecodeCheckAccessResponse(decoder func(*http.Response) goahttp.Decoder, res
 				return nil, goahttp.ErrValidationError("access-svc", "check-access", err)
 			}
 			return nil, NewCheckAccessUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body CheckAccessForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "check-access", err)
+			}
+			err = ValidateCheckAccessForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "check-access", err)
+			}
+			return nil, NewCheckAccessForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
 				body CheckAccessInternalServerErrorResponseBody
@@ -156,6 +177,34 @@ func DecodeCheckAccessResponse(decoder func(*http.Response) goahttp.Decoder, res
 				return nil, goahttp.ErrValidationError("access-svc", "check-access", err)
 			}
 			return nil, NewCheckAccessServiceUnavailable(&body)
+		case http.StatusGatewayTimeout:
+			var (
+				body CheckAccessGatewayTimeoutResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "check-access", err)
+			}
+			err = ValidateCheckAccessGatewayTimeoutResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "check-access", err)
+			}
+			return nil, NewCheckAccessGatewayTimeout(&body)
+		case http.StatusTooManyRequests:
+			var (
+				body CheckAccessTooManyRequestsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "check-access", err)
+			}
+			err = ValidateCheckAccessTooManyRequestsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "check-access", err)
+			}
+			return nil, NewCheckAccessTooManyRequests(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("access-svc", "check-access", resp.StatusCode, string(body))
@@ -196,7 +245,16 @@ func EncodeMyGrantsRequest(encoder func(*http.Request) goahttp.Encoder) func(*ht
 		}
 		values := req.URL.Query()
 		values.Add("v", p.Version)
-		values.Add("object_type", p.ObjectType)
+		for _, value := range p.ObjectType {
+			values.Add("object_type", value)
+		}
+		if p.Relation != nil {
+			values.Add("relation", *p.Relation)
+		}
+		values.Add("page_size", fmt.Sprintf("%v", p.PageSize))
+		if p.ContinuationToken != nil {
+			values.Add("continuation_token", *p.ContinuationToken)
+		}
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
@@ -210,6 +268,8 @@ func EncodeMyGrantsRequest(encoder func(*http.Request) goahttp.Encoder) func(*ht
 //   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
 //   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
 //   - "ServiceUnavailable" (type *goa.ServiceError): http.StatusServiceUnavailable
+//   - "GatewayTimeout" (type *goa.ServiceError): http.StatusGatewayTimeout
+//   - "TooManyRequests" (type *goa.ServiceError): http.StatusTooManyRequests
 //   - error: internal error
 func DecodeMyGrantsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -297,6 +357,34 @@ func DecodeMyGrantsResponse(decoder func(*http.Response) goahttp.Decoder, restor
 				return nil, goahttp.ErrValidationError("access-svc", "my-grants", err)
 			}
 			return nil, NewMyGrantsServiceUnavailable(&body)
+		case http.StatusGatewayTimeout:
+			var (
+				body MyGrantsGatewayTimeoutResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "my-grants", err)
+			}
+			err = ValidateMyGrantsGatewayTimeoutResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "my-grants", err)
+			}
+			return nil, NewMyGrantsGatewayTimeout(&body)
+		case http.StatusTooManyRequests:
+			var (
+				body MyGrantsTooManyRequestsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "my-grants", err)
+			}
+			err = ValidateMyGrantsTooManyRequestsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "my-grants", err)
+			}
+			return nil, NewMyGrantsTooManyRequests(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("access-svc", "my-grants", resp.StatusCode, string(body))
@@ -304,6 +392,536 @@ func DecodeMyGrantsResponse(decoder func(*http.Response) goahttp.Decoder, restor
 	}
 }
 
+// BuildListObjectsRequest instantiates a HTTP request object with method and
+// path set to call the "access-svc" service "list-objects" endpoint
+func (c *Client) BuildListObjectsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListObjectsAccessSvcPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("access-svc", "list-objects", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListObjectsRequest returns an encoder for requests sent to the
+// access-svc list-objects server.
+func EncodeListObjectsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*accesssvc.ListObjectsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("access-svc", "list-objects", "*accesssvc.ListObjectsPayload", v)
+		}
+		{
+			head := p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		values.Add("object_type", p.ObjectType)
+		values.Add("relation", p.Relation)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListObjectsResponse returns a decoder for responses returned by the
+// access-svc list-objects endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListObjectsResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *goa.ServiceError): http.StatusServiceUnavailable
+//   - "GatewayTimeout" (type *goa.ServiceError): http.StatusGatewayTimeout
+//   - "TooManyRequests" (type *goa.ServiceError): http.StatusTooManyRequests
+//   - error: internal error
+func DecodeListObjectsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListObjectsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "list-objects", err)
+			}
+			err = ValidateListObjectsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "list-objects", err)
+			}
+			res := NewListObjectsResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListObjectsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "list-objects", err)
+			}
+			err = ValidateListObjectsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "list-objects", err)
+			}
+			return nil, NewListObjectsBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListObjectsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "list-objects", err)
+			}
+			err = ValidateListObjectsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "list-objects", err)
+			}
+			return nil, NewListObjectsUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListObjectsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "list-objects", err)
+			}
+			err = ValidateListObjectsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "list-objects", err)
+			}
+			return nil, NewListObjectsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListObjectsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "list-objects", err)
+			}
+			err = ValidateListObjectsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "list-objects", err)
+			}
+			return nil, NewListObjectsServiceUnavailable(&body)
+		case http.StatusGatewayTimeout:
+			var (
+				body ListObjectsGatewayTimeoutResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "list-objects", err)
+			}
+			err = ValidateListObjectsGatewayTimeoutResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "list-objects", err)
+			}
+			return nil, NewListObjectsGatewayTimeout(&body)
+		case http.StatusTooManyRequests:
+			var (
+				body ListObjectsTooManyRequestsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "list-objects", err)
+			}
+			err = ValidateListObjectsTooManyRequestsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "list-objects", err)
+			}
+			return nil, NewListObjectsTooManyRequests(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("access-svc", "list-objects", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListUsersRequest instantiates a HTTP request object with method and
+// path set to call the "access-svc" service "list-users" endpoint
+func (c *Client) BuildListUsersRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListUsersAccessSvcPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("access-svc", "list-users", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListUsersRequest returns an encoder for requests sent to the
+// access-svc list-users server.
+func EncodeListUsersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*accesssvc.ListUsersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("access-svc", "list-users", "*accesssvc.ListUsersPayload", v)
+		}
+		{
+			head := p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		values.Add("object", p.Object)
+		values.Add("relation", p.Relation)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListUsersResponse returns a decoder for responses returned by the
+// access-svc list-users endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListUsersResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "Forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *goa.ServiceError): http.StatusServiceUnavailable
+//   - "GatewayTimeout" (type *goa.ServiceError): http.StatusGatewayTimeout
+//   - "TooManyRequests" (type *goa.ServiceError): http.StatusTooManyRequests
+//   - error: internal error
+func DecodeListUsersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListUsersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "list-users", err)
+			}
+			err = ValidateListUsersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "list-users", err)
+			}
+			res := NewListUsersResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListUsersBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "list-users", err)
+			}
+			err = ValidateListUsersBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "list-users", err)
+			}
+			return nil, NewListUsersBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListUsersUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "list-users", err)
+			}
+			err = ValidateListUsersUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "list-users", err)
+			}
+			return nil, NewListUsersUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ListUsersForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "list-users", err)
+			}
+			err = ValidateListUsersForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "list-users", err)
+			}
+			return nil, NewListUsersForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListUsersInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "list-users", err)
+			}
+			err = ValidateListUsersInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "list-users", err)
+			}
+			return nil, NewListUsersInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListUsersServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "list-users", err)
+			}
+			err = ValidateListUsersServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "list-users", err)
+			}
+			return nil, NewListUsersServiceUnavailable(&body)
+		case http.StatusGatewayTimeout:
+			var (
+				body ListUsersGatewayTimeoutResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "list-users", err)
+			}
+			err = ValidateListUsersGatewayTimeoutResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "list-users", err)
+			}
+			return nil, NewListUsersGatewayTimeout(&body)
+		case http.StatusTooManyRequests:
+			var (
+				body ListUsersTooManyRequestsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "list-users", err)
+			}
+			err = ValidateListUsersTooManyRequestsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "list-users", err)
+			}
+			return nil, NewListUsersTooManyRequests(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("access-svc", "list-users", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildMyPermissionsRequest instantiates a HTTP request object with method and
+// path set to call the "access-svc" service "my-permissions" endpoint
+func (c *Client) BuildMyPermissionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: MyPermissionsAccessSvcPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("access-svc", "my-permissions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeMyPermissionsRequest returns an encoder for requests sent to the
+// access-svc my-permissions server.
+func EncodeMyPermissionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*accesssvc.MyPermissionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("access-svc", "my-permissions", "*accesssvc.MyPermissionsPayload", v)
+		}
+		{
+			head := p.BearerToken
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("v", p.Version)
+		values.Add("object", p.Object)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeMyPermissionsResponse returns a decoder for responses returned by the
+// access-svc my-permissions endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeMyPermissionsResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *goa.ServiceError): http.StatusServiceUnavailable
+//   - "GatewayTimeout" (type *goa.ServiceError): http.StatusGatewayTimeout
+//   - "TooManyRequests" (type *goa.ServiceError): http.StatusTooManyRequests
+//   - error: internal error
+func DecodeMyPermissionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body MyPermissionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "my-permissions", err)
+			}
+			err = ValidateMyPermissionsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "my-permissions", err)
+			}
+			res := NewMyPermissionsResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body MyPermissionsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "my-permissions", err)
+			}
+			err = ValidateMyPermissionsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "my-permissions", err)
+			}
+			return nil, NewMyPermissionsBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body MyPermissionsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "my-permissions", err)
+			}
+			err = ValidateMyPermissionsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "my-permissions", err)
+			}
+			return nil, NewMyPermissionsUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body MyPermissionsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "my-permissions", err)
+			}
+			err = ValidateMyPermissionsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "my-permissions", err)
+			}
+			return nil, NewMyPermissionsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body MyPermissionsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "my-permissions", err)
+			}
+			err = ValidateMyPermissionsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "my-permissions", err)
+			}
+			return nil, NewMyPermissionsServiceUnavailable(&body)
+		case http.StatusGatewayTimeout:
+			var (
+				body MyPermissionsGatewayTimeoutResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "my-permissions", err)
+			}
+			err = ValidateMyPermissionsGatewayTimeoutResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "my-permissions", err)
+			}
+			return nil, NewMyPermissionsGatewayTimeout(&body)
+		case http.StatusTooManyRequests:
+			var (
+				body MyPermissionsTooManyRequestsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("access-svc", "my-permissions", err)
+			}
+			err = ValidateMyPermissionsTooManyRequestsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("access-svc", "my-permissions", err)
+			}
+			return nil, NewMyPermissionsTooManyRequests(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("access-svc", "my-permissions", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildReadyzRequest instantiates a HTTP request object with method and path
 // set to call the "access-svc" service "readyz" endpoint
 func (c *Client) BuildReadyzRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -420,3 +1038,54 @@ func DecodeLivezResponse(decoder func(*http.Response) goahttp.Decoder, restoreBo
 		}
 	}
 }
+
+// marshalAccesssvcContextualTupleToContextualTupleRequestBody builds a value
+// of type *ContextualTupleRequestBody from a value of type
+// *accesssvc.ContextualTuple.
+func marshalAccesssvcContextualTupleToContextualTupleRequestBody(v *accesssvc.ContextualTuple) *ContextualTupleRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &ContextualTupleRequestBody{
+		Object:   v.Object,
+		Relation: v.Relation,
+		User:     v.User,
+	}
+
+	return res
+}
+
+// marshalContextualTupleRequestBodyToAccesssvcContextualTuple builds a value
+// of type *accesssvc.ContextualTuple from a value of type
+// *ContextualTupleRequestBody.
+func marshalContextualTupleRequestBodyToAccesssvcContextualTuple(v *ContextualTupleRequestBody) *accesssvc.ContextualTuple {
+	if v == nil {
+		return nil
+	}
+	res := &accesssvc.ContextualTuple{
+		Object:   v.Object,
+		Relation: v.Relation,
+		User:     v.User,
+	}
+
+	return res
+}
+
+// unmarshalAccessCheckDecisionResponseBodyToAccesssvcviewsAccessCheckDecisionView
+// builds a value of type *accesssvcviews.AccessCheckDecisionView from a value
+// of type *AccessCheckDecisionResponseBody.
+func unmarshalAccessCheckDecisionResponseBodyToAccesssvcviewsAccessCheckDecisionView(v *AccessCheckDecisionResponseBody) *accesssvcviews.AccessCheckDecisionView {
+	if v == nil {
+		return nil
+	}
+	res := &accesssvcviews.AccessCheckDecisionView{
+		Object:     v.Object,
+		Relation:   v.Relation,
+		User:       v.User,
+		Allowed:    v.Allowed,
+		Stale:      v.Stale,
+		Contextual: v.Contextual,
+	}
+
+	return res
+}
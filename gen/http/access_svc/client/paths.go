@@ -17,6 +17,21 @@ func MyGrantsAccessSvcPath() string {
 	return "/my-grants"
 }
 
+// ListObjectsAccessSvcPath returns the URL path to the access-svc service list-objects HTTP endpoint.
+func ListObjectsAccessSvcPath() string {
+	return "/my-objects"
+}
+
+// ListUsersAccessSvcPath returns the URL path to the access-svc service list-users HTTP endpoint.
+func ListUsersAccessSvcPath() string {
+	return "/object-users"
+}
+
+// MyPermissionsAccessSvcPath returns the URL path to the access-svc service my-permissions HTTP endpoint.
+func MyPermissionsAccessSvcPath() string {
+	return "/my-permissions"
+}
+
 // ReadyzAccessSvcPath returns the URL path to the access-svc service readyz HTTP endpoint.
 func ReadyzAccessSvcPath() string {
 	return "/readyz"
@@ -8,30 +8,71 @@
 package client
 
 import (
+	"unicode/utf8"
+
 	accesssvc "github.com/linuxfoundation/lfx-v2-access-check/gen/access_svc"
+	accesssvcviews "github.com/linuxfoundation/lfx-v2-access-check/gen/access_svc/views"
 	goa "goa.design/goa/v3/pkg"
 )
 
 // CheckAccessRequestBody is the type of the "access-svc" service
 // "check-access" endpoint HTTP request body.
 type CheckAccessRequestBody struct {
-	// Resource-action pairs to check
+	// Resource-action pairs to check, each in strict 'type:id#relation' form; at
+	// most 1000 per call
 	Requests []string `form:"requests" json:"requests" xml:"requests"`
+	// Tuples considered in addition to stored tuples for every check in this call;
+	// only accepted from allowed clients, and never for the checked user
+	ContextualTuples []*ContextualTupleRequestBody `form:"contextual_tuples,omitempty" json:"contextual_tuples,omitempty" xml:"contextual_tuples,omitempty"`
+	// Condition context for every check in this call, e.g. the current time or
+	// client IP; only accepted from allowed clients
+	Context map[string]any `form:"context,omitempty" json:"context,omitempty" xml:"context,omitempty"`
 }
 
 // CheckAccessResponseBody is the type of the "access-svc" service
 // "check-access" endpoint HTTP response body.
 type CheckAccessResponseBody struct {
-	// Access check results — each entry is 'object#relation@user\ttrue' or
+	// Access check results (v1) — each entry is 'object#relation@user\ttrue' or
 	// 'object#relation@user\tfalse'
 	Results []string `form:"results,omitempty" json:"results,omitempty" xml:"results,omitempty"`
+	// Structured access check results (v2)
+	Decisions []*AccessCheckDecisionResponseBody `form:"decisions,omitempty" json:"decisions,omitempty" xml:"decisions,omitempty"`
 }
 
 // MyGrantsResponseBody is the type of the "access-svc" service "my-grants"
 // endpoint HTTP response body.
 type MyGrantsResponseBody struct {
-	// Direct access grants as tuple-strings
+	// Direct access grants as tuple-strings, grouped in requested object type order
 	Grants []string `form:"grants,omitempty" json:"grants,omitempty" xml:"grants,omitempty"`
+	// Direct access grants keyed by object type; every requested type is present
+	GrantsByType map[string][]string `form:"grants_by_type,omitempty" json:"grants_by_type,omitempty" xml:"grants_by_type,omitempty"`
+	// Opaque token to fetch the next page; absent on the last page
+	ContinuationToken *string `form:"continuation_token,omitempty" json:"continuation_token,omitempty" xml:"continuation_token,omitempty"`
+}
+
+// ListObjectsResponseBody is the type of the "access-svc" service
+// "list-objects" endpoint HTTP response body.
+type ListObjectsResponseBody struct {
+	// Objects, in 'type:id' form, on which the caller holds the relation
+	Objects []string `form:"objects,omitempty" json:"objects,omitempty" xml:"objects,omitempty"`
+}
+
+// ListUsersResponseBody is the type of the "access-svc" service "list-users"
+// endpoint HTTP response body.
+type ListUsersResponseBody struct {
+	// Users holding the relation
+	Users []string `form:"users,omitempty" json:"users,omitempty" xml:"users,omitempty"`
+	// Usersets holding the relation
+	Usersets []string `form:"usersets,omitempty" json:"usersets,omitempty" xml:"usersets,omitempty"`
+}
+
+// MyPermissionsResponseBody is the type of the "access-svc" service
+// "my-permissions" endpoint HTTP response body.
+type MyPermissionsResponseBody struct {
+	// Object the relations were checked on
+	Object *string `form:"object,omitempty" json:"object,omitempty" xml:"object,omitempty"`
+	// Relations the caller holds on the object, in permission model order
+	Relations []string `form:"relations,omitempty" json:"relations,omitempty" xml:"relations,omitempty"`
 }
 
 // CheckAccessBadRequestResponseBody is the type of the "access-svc" service
@@ -70,6 +111,24 @@ type CheckAccessUnauthorizedResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// CheckAccessForbiddenResponseBody is the type of the "access-svc" service
+// "check-access" endpoint HTTP response body for the "Forbidden" error.
+type CheckAccessForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // CheckAccessInternalServerErrorResponseBody is the type of the "access-svc"
 // service "check-access" endpoint HTTP response body for the
 // "InternalServerError" error.
@@ -108,6 +167,44 @@ type CheckAccessServiceUnavailableResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// CheckAccessGatewayTimeoutResponseBody is the type of the "access-svc"
+// service "check-access" endpoint HTTP response body for the "GatewayTimeout"
+// error.
+type CheckAccessGatewayTimeoutResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CheckAccessTooManyRequestsResponseBody is the type of the "access-svc"
+// service "check-access" endpoint HTTP response body for the "TooManyRequests"
+// error.
+type CheckAccessTooManyRequestsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // MyGrantsBadRequestResponseBody is the type of the "access-svc" service
 // "my-grants" endpoint HTTP response body for the "BadRequest" error.
 type MyGrantsBadRequestResponseBody struct {
@@ -182,9 +279,9 @@ type MyGrantsServiceUnavailableResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ReadyzNotReadyResponseBody is the type of the "access-svc" service "readyz"
-// endpoint HTTP response body for the "NotReady" error.
-type ReadyzNotReadyResponseBody struct {
+// MyGrantsGatewayTimeoutResponseBody is the type of the "access-svc" service
+// "my-grants" endpoint HTTP response body for the "GatewayTimeout" error.
+type MyGrantsGatewayTimeoutResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -200,200 +297,1663 @@ type ReadyzNotReadyResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCheckAccessRequestBody builds the HTTP request body from the payload of
-// the "check-access" endpoint of the "access-svc" service.
-func NewCheckAccessRequestBody(p *accesssvc.CheckAccessPayload) *CheckAccessRequestBody {
-	body := &CheckAccessRequestBody{}
-	if p.Requests != nil {
-		body.Requests = make([]string, len(p.Requests))
-		for i, val := range p.Requests {
-			body.Requests[i] = val
-		}
-	} else {
-		body.Requests = []string{}
-	}
-	return body
+// MyGrantsTooManyRequestsResponseBody is the type of the "access-svc" service
+// "my-grants" endpoint HTTP response body for the "TooManyRequests" error.
+type MyGrantsTooManyRequestsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCheckAccessResultOK builds a "access-svc" service "check-access" endpoint
-// result from a HTTP "OK" response.
-func NewCheckAccessResultOK(body *CheckAccessResponseBody) *accesssvc.CheckAccessResult {
-	v := &accesssvc.CheckAccessResult{}
-	v.Results = make([]string, len(body.Results))
-	for i, val := range body.Results {
-		v.Results[i] = val
-	}
+// ListObjectsBadRequestResponseBody is the type of the "access-svc" service
+// "list-objects" endpoint HTTP response body for the "BadRequest" error.
+type ListObjectsBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
 
-	return v
+// ListObjectsUnauthorizedResponseBody is the type of the "access-svc" service
+// "list-objects" endpoint HTTP response body for the "Unauthorized" error.
+type ListObjectsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCheckAccessBadRequest builds a access-svc service check-access endpoint
-// BadRequest error.
-func NewCheckAccessBadRequest(body *CheckAccessBadRequestResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
+// ListObjectsInternalServerErrorResponseBody is the type of the "access-svc"
+// service "list-objects" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListObjectsInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
 
-	return v
+// ListObjectsServiceUnavailableResponseBody is the type of the "access-svc"
+// service "list-objects" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ListObjectsServiceUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCheckAccessUnauthorized builds a access-svc service check-access endpoint
-// Unauthorized error.
-func NewCheckAccessUnauthorized(body *CheckAccessUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
+// ListObjectsGatewayTimeoutResponseBody is the type of the "access-svc"
+// service "list-objects" endpoint HTTP response body for the "GatewayTimeout"
+// error.
+type ListObjectsGatewayTimeoutResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
 
-	return v
+// ListObjectsTooManyRequestsResponseBody is the type of the "access-svc"
+// service "list-objects" endpoint HTTP response body for the "TooManyRequests"
+// error.
+type ListObjectsTooManyRequestsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCheckAccessInternalServerError builds a access-svc service check-access
-// endpoint InternalServerError error.
-func NewCheckAccessInternalServerError(body *CheckAccessInternalServerErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
+// ListUsersBadRequestResponseBody is the type of the "access-svc" service
+// "list-users" endpoint HTTP response body for the "BadRequest" error.
+type ListUsersBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
 
-	return v
+// ListUsersUnauthorizedResponseBody is the type of the "access-svc" service
+// "list-users" endpoint HTTP response body for the "Unauthorized" error.
+type ListUsersUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCheckAccessServiceUnavailable builds a access-svc service check-access
-// endpoint ServiceUnavailable error.
-func NewCheckAccessServiceUnavailable(body *CheckAccessServiceUnavailableResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ListUsersForbiddenResponseBody is the type of the "access-svc" service
+// "list-users" endpoint HTTP response body for the "Forbidden" error.
+type ListUsersForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListUsersInternalServerErrorResponseBody is the type of the "access-svc"
+// service "list-users" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListUsersInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListUsersServiceUnavailableResponseBody is the type of the "access-svc"
+// service "list-users" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ListUsersServiceUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListUsersGatewayTimeoutResponseBody is the type of the "access-svc" service
+// "list-users" endpoint HTTP response body for the "GatewayTimeout" error.
+type ListUsersGatewayTimeoutResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListUsersTooManyRequestsResponseBody is the type of the "access-svc" service
+// "list-users" endpoint HTTP response body for the "TooManyRequests" error.
+type ListUsersTooManyRequestsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// MyPermissionsBadRequestResponseBody is the type of the "access-svc" service
+// "my-permissions" endpoint HTTP response body for the "BadRequest" error.
+type MyPermissionsBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// MyPermissionsUnauthorizedResponseBody is the type of the "access-svc"
+// service "my-permissions" endpoint HTTP response body for the "Unauthorized"
+// error.
+type MyPermissionsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// MyPermissionsInternalServerErrorResponseBody is the type of the "access-svc"
+// service "my-permissions" endpoint HTTP response body for the
+// "InternalServerError" error.
+type MyPermissionsInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// MyPermissionsServiceUnavailableResponseBody is the type of the "access-svc"
+// service "my-permissions" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type MyPermissionsServiceUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// MyPermissionsGatewayTimeoutResponseBody is the type of the "access-svc"
+// service "my-permissions" endpoint HTTP response body for the
+// "GatewayTimeout" error.
+type MyPermissionsGatewayTimeoutResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// MyPermissionsTooManyRequestsResponseBody is the type of the "access-svc"
+// service "my-permissions" endpoint HTTP response body for the
+// "TooManyRequests" error.
+type MyPermissionsTooManyRequestsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ReadyzNotReadyResponseBody is the type of the "access-svc" service "readyz"
+// endpoint HTTP response body for the "NotReady" error.
+type ReadyzNotReadyResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ContextualTupleRequestBody is used to define fields on request body types.
+type ContextualTupleRequestBody struct {
+	// Object in type:id form
+	Object string `form:"object" json:"object" xml:"object"`
+	// Relation the user holds on the object
+	Relation string `form:"relation" json:"relation" xml:"relation"`
+	// Principal holding the relation; never the caller, a wildcard, a userset or
+	// an object
+	User string `form:"user" json:"user" xml:"user"`
+}
+
+// AccessCheckDecisionResponseBody is used to define fields on response body
+// types.
+type AccessCheckDecisionResponseBody struct {
+	// Object in type:id form
+	Object *string `form:"object,omitempty" json:"object,omitempty" xml:"object,omitempty"`
+	// Relation checked on the object
+	Relation *string `form:"relation,omitempty" json:"relation,omitempty" xml:"relation,omitempty"`
+	// User the relation was checked for
+	User *string `form:"user,omitempty" json:"user,omitempty" xml:"user,omitempty"`
+	// Whether the user holds the relation on the object
+	Allowed *bool `form:"allowed,omitempty" json:"allowed,omitempty" xml:"allowed,omitempty"`
+	// Set when fga-sync was unavailable and the decision came from an expired
+	// cache entry or failed closed
+	Stale *bool `form:"stale,omitempty" json:"stale,omitempty" xml:"stale,omitempty"`
+	// Set when the decision was made with the call's contextual tuples rather than
+	// stored tuples alone
+	Contextual *bool `form:"contextual,omitempty" json:"contextual,omitempty" xml:"contextual,omitempty"`
+}
+
+// NewCheckAccessRequestBody builds the HTTP request body from the payload of
+// the "check-access" endpoint of the "access-svc" service.
+func NewCheckAccessRequestBody(p *accesssvc.CheckAccessPayload) *CheckAccessRequestBody {
+	body := &CheckAccessRequestBody{}
+	if p.Requests != nil {
+		body.Requests = make([]string, len(p.Requests))
+		for i, val := range p.Requests {
+			body.Requests[i] = val
+		}
+	} else {
+		body.Requests = []string{}
+	}
+	if p.ContextualTuples != nil {
+		body.ContextualTuples = make([]*ContextualTupleRequestBody, len(p.ContextualTuples))
+		for i, val := range p.ContextualTuples {
+			if val == nil {
+				body.ContextualTuples[i] = nil
+				continue
+			}
+			body.ContextualTuples[i] = marshalAccesssvcContextualTupleToContextualTupleRequestBody(val)
+		}
+	}
+	if p.Context != nil {
+		body.Context = make(map[string]any, len(p.Context))
+		for key, val := range p.Context {
+			tk := key
+			tv := val
+			body.Context[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewCheckAccessResultViewOK builds a "access-svc" service "check-access"
+// endpoint result from a HTTP "OK" response.
+func NewCheckAccessResultViewOK(body *CheckAccessResponseBody) *accesssvcviews.CheckAccessResultView {
+	v := &accesssvcviews.CheckAccessResultView{}
+	v.Results = make([]string, len(body.Results))
+	for i, val := range body.Results {
+		v.Results[i] = val
+	}
+	if body.Decisions != nil {
+		v.Decisions = make([]*accesssvcviews.AccessCheckDecisionView, len(body.Decisions))
+		for i, val := range body.Decisions {
+			if val == nil {
+				v.Decisions[i] = nil
+				continue
+			}
+			v.Decisions[i] = unmarshalAccessCheckDecisionResponseBodyToAccesssvcviewsAccessCheckDecisionView(val)
+		}
+	}
+
+	return v
+}
+
+// NewCheckAccessBadRequest builds a access-svc service check-access endpoint
+// BadRequest error.
+func NewCheckAccessBadRequest(body *CheckAccessBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckAccessUnauthorized builds a access-svc service check-access endpoint
+// Unauthorized error.
+func NewCheckAccessUnauthorized(body *CheckAccessUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckAccessForbidden builds a access-svc service check-access endpoint
+// Forbidden error.
+func NewCheckAccessForbidden(body *CheckAccessForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckAccessInternalServerError builds a access-svc service check-access
+// endpoint InternalServerError error.
+func NewCheckAccessInternalServerError(body *CheckAccessInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckAccessServiceUnavailable builds a access-svc service check-access
+// endpoint ServiceUnavailable error.
+func NewCheckAccessServiceUnavailable(body *CheckAccessServiceUnavailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckAccessGatewayTimeout builds a access-svc service check-access
+// endpoint GatewayTimeout error.
+func NewCheckAccessGatewayTimeout(body *CheckAccessGatewayTimeoutResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckAccessTooManyRequests builds a access-svc service check-access
+// endpoint TooManyRequests error.
+func NewCheckAccessTooManyRequests(body *CheckAccessTooManyRequestsResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMyGrantsResultOK builds a "access-svc" service "my-grants" endpoint
+// result from a HTTP "OK" response.
+func NewMyGrantsResultOK(body *MyGrantsResponseBody) *accesssvc.MyGrantsResult {
+	v := &accesssvc.MyGrantsResult{
+		ContinuationToken: body.ContinuationToken,
+	}
+	v.Grants = make([]string, len(body.Grants))
+	for i, val := range body.Grants {
+		v.Grants[i] = val
+	}
+	v.GrantsByType = make(map[string][]string, len(body.GrantsByType))
+	for key, val := range body.GrantsByType {
+		tk := key
+		tv := make([]string, len(val))
+		for i, val := range val {
+			tv[i] = val
+		}
+		v.GrantsByType[tk] = tv
+	}
+
+	return v
+}
+
+// NewMyGrantsBadRequest builds a access-svc service my-grants endpoint
+// BadRequest error.
+func NewMyGrantsBadRequest(body *MyGrantsBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMyGrantsUnauthorized builds a access-svc service my-grants endpoint
+// Unauthorized error.
+func NewMyGrantsUnauthorized(body *MyGrantsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMyGrantsInternalServerError builds a access-svc service my-grants
+// endpoint InternalServerError error.
+func NewMyGrantsInternalServerError(body *MyGrantsInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMyGrantsServiceUnavailable builds a access-svc service my-grants endpoint
+// ServiceUnavailable error.
+func NewMyGrantsServiceUnavailable(body *MyGrantsServiceUnavailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMyGrantsGatewayTimeout builds a access-svc service my-grants endpoint
+// GatewayTimeout error.
+func NewMyGrantsGatewayTimeout(body *MyGrantsGatewayTimeoutResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMyGrantsTooManyRequests builds a access-svc service my-grants endpoint
+// TooManyRequests error.
+func NewMyGrantsTooManyRequests(body *MyGrantsTooManyRequestsResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListObjectsResultOK builds a "access-svc" service "list-objects" endpoint
+// result from a HTTP "OK" response.
+func NewListObjectsResultOK(body *ListObjectsResponseBody) *accesssvc.ListObjectsResult {
+	v := &accesssvc.ListObjectsResult{}
+	v.Objects = make([]string, len(body.Objects))
+	for i, val := range body.Objects {
+		v.Objects[i] = val
+	}
+
+	return v
+}
+
+// NewListObjectsBadRequest builds a access-svc service list-objects endpoint
+// BadRequest error.
+func NewListObjectsBadRequest(body *ListObjectsBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListObjectsUnauthorized builds a access-svc service list-objects endpoint
+// Unauthorized error.
+func NewListObjectsUnauthorized(body *ListObjectsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListObjectsInternalServerError builds a access-svc service list-objects
+// endpoint InternalServerError error.
+func NewListObjectsInternalServerError(body *ListObjectsInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListObjectsServiceUnavailable builds a access-svc service list-objects
+// endpoint ServiceUnavailable error.
+func NewListObjectsServiceUnavailable(body *ListObjectsServiceUnavailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListObjectsGatewayTimeout builds a access-svc service list-objects
+// endpoint GatewayTimeout error.
+func NewListObjectsGatewayTimeout(body *ListObjectsGatewayTimeoutResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListObjectsTooManyRequests builds a access-svc service list-objects
+// endpoint TooManyRequests error.
+func NewListObjectsTooManyRequests(body *ListObjectsTooManyRequestsResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListUsersResultOK builds a "access-svc" service "list-users" endpoint
+// result from a HTTP "OK" response.
+func NewListUsersResultOK(body *ListUsersResponseBody) *accesssvc.ListUsersResult {
+	v := &accesssvc.ListUsersResult{}
+	v.Users = make([]string, len(body.Users))
+	for i, val := range body.Users {
+		v.Users[i] = val
+	}
+	v.Usersets = make([]string, len(body.Usersets))
+	for i, val := range body.Usersets {
+		v.Usersets[i] = val
+	}
+
+	return v
+}
+
+// NewListUsersBadRequest builds a access-svc service list-users endpoint
+// BadRequest error.
+func NewListUsersBadRequest(body *ListUsersBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListUsersUnauthorized builds a access-svc service list-users endpoint
+// Unauthorized error.
+func NewListUsersUnauthorized(body *ListUsersUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListUsersForbidden builds a access-svc service list-users endpoint
+// Forbidden error.
+func NewListUsersForbidden(body *ListUsersForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListUsersInternalServerError builds a access-svc service list-users
+// endpoint InternalServerError error.
+func NewListUsersInternalServerError(body *ListUsersInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListUsersServiceUnavailable builds a access-svc service list-users
+// endpoint ServiceUnavailable error.
+func NewListUsersServiceUnavailable(body *ListUsersServiceUnavailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListUsersGatewayTimeout builds a access-svc service list-users endpoint
+// GatewayTimeout error.
+func NewListUsersGatewayTimeout(body *ListUsersGatewayTimeoutResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListUsersTooManyRequests builds a access-svc service list-users endpoint
+// TooManyRequests error.
+func NewListUsersTooManyRequests(body *ListUsersTooManyRequestsResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMyPermissionsResultOK builds a "access-svc" service "my-permissions"
+// endpoint result from a HTTP "OK" response.
+func NewMyPermissionsResultOK(body *MyPermissionsResponseBody) *accesssvc.MyPermissionsResult {
+	v := &accesssvc.MyPermissionsResult{
+		Object: *body.Object,
+	}
+	v.Relations = make([]string, len(body.Relations))
+	for i, val := range body.Relations {
+		v.Relations[i] = val
+	}
+
+	return v
+}
+
+// NewMyPermissionsBadRequest builds a access-svc service my-permissions
+// endpoint BadRequest error.
+func NewMyPermissionsBadRequest(body *MyPermissionsBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMyPermissionsUnauthorized builds a access-svc service my-permissions
+// endpoint Unauthorized error.
+func NewMyPermissionsUnauthorized(body *MyPermissionsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMyPermissionsInternalServerError builds a access-svc service
+// my-permissions endpoint InternalServerError error.
+func NewMyPermissionsInternalServerError(body *MyPermissionsInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMyPermissionsServiceUnavailable builds a access-svc service
+// my-permissions endpoint ServiceUnavailable error.
+func NewMyPermissionsServiceUnavailable(body *MyPermissionsServiceUnavailableResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMyPermissionsGatewayTimeout builds a access-svc service my-permissions
+// endpoint GatewayTimeout error.
+func NewMyPermissionsGatewayTimeout(body *MyPermissionsGatewayTimeoutResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMyPermissionsTooManyRequests builds a access-svc service my-permissions
+// endpoint TooManyRequests error.
+func NewMyPermissionsTooManyRequests(body *MyPermissionsTooManyRequestsResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewReadyzNotReady builds a access-svc service readyz endpoint NotReady error.
+func NewReadyzNotReady(body *ReadyzNotReadyResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// ValidateMyGrantsResponseBody runs the validations defined on
+// My-GrantsResponseBody
+func ValidateMyGrantsResponseBody(body *MyGrantsResponseBody) (err error) {
+	if body.Grants == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("grants", "body"))
+	}
+	if body.GrantsByType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("grants_by_type", "body"))
+	}
+	return
+}
+
+// ValidateListObjectsResponseBody runs the validations defined on
+// List-ObjectsResponseBody
+func ValidateListObjectsResponseBody(body *ListObjectsResponseBody) (err error) {
+	if body.Objects == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("objects", "body"))
+	}
+	return
+}
+
+// ValidateListUsersResponseBody runs the validations defined on
+// List-UsersResponseBody
+func ValidateListUsersResponseBody(body *ListUsersResponseBody) (err error) {
+	if body.Users == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("users", "body"))
+	}
+	if body.Usersets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("usersets", "body"))
+	}
+	return
+}
+
+// ValidateMyPermissionsResponseBody runs the validations defined on
+// My-PermissionsResponseBody
+func ValidateMyPermissionsResponseBody(body *MyPermissionsResponseBody) (err error) {
+	if body.Object == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("object", "body"))
+	}
+	if body.Relations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("relations", "body"))
+	}
+	return
+}
+
+// ValidateCheckAccessBadRequestResponseBody runs the validations defined on
+// check-access_BadRequest_response_body
+func ValidateCheckAccessBadRequestResponseBody(body *CheckAccessBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCheckAccessUnauthorizedResponseBody runs the validations defined on
+// check-access_Unauthorized_response_body
+func ValidateCheckAccessUnauthorizedResponseBody(body *CheckAccessUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCheckAccessForbiddenResponseBody runs the validations defined on
+// check-access_Forbidden_response_body
+func ValidateCheckAccessForbiddenResponseBody(body *CheckAccessForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCheckAccessInternalServerErrorResponseBody runs the validations
+// defined on check-access_InternalServerError_response_body
+func ValidateCheckAccessInternalServerErrorResponseBody(body *CheckAccessInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCheckAccessServiceUnavailableResponseBody runs the validations
+// defined on check-access_ServiceUnavailable_response_body
+func ValidateCheckAccessServiceUnavailableResponseBody(body *CheckAccessServiceUnavailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCheckAccessGatewayTimeoutResponseBody runs the validations defined
+// on check-access_GatewayTimeout_response_body
+func ValidateCheckAccessGatewayTimeoutResponseBody(body *CheckAccessGatewayTimeoutResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCheckAccessTooManyRequestsResponseBody runs the validations defined
+// on check-access_TooManyRequests_response_body
+func ValidateCheckAccessTooManyRequestsResponseBody(body *CheckAccessTooManyRequestsResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateMyGrantsBadRequestResponseBody runs the validations defined on
+// my-grants_BadRequest_response_body
+func ValidateMyGrantsBadRequestResponseBody(body *MyGrantsBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateMyGrantsUnauthorizedResponseBody runs the validations defined on
+// my-grants_Unauthorized_response_body
+func ValidateMyGrantsUnauthorizedResponseBody(body *MyGrantsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateMyGrantsInternalServerErrorResponseBody runs the validations defined
+// on my-grants_InternalServerError_response_body
+func ValidateMyGrantsInternalServerErrorResponseBody(body *MyGrantsInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateMyGrantsServiceUnavailableResponseBody runs the validations defined
+// on my-grants_ServiceUnavailable_response_body
+func ValidateMyGrantsServiceUnavailableResponseBody(body *MyGrantsServiceUnavailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateMyGrantsGatewayTimeoutResponseBody runs the validations defined on
+// my-grants_GatewayTimeout_response_body
+func ValidateMyGrantsGatewayTimeoutResponseBody(body *MyGrantsGatewayTimeoutResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateMyGrantsTooManyRequestsResponseBody runs the validations defined on
+// my-grants_TooManyRequests_response_body
+func ValidateMyGrantsTooManyRequestsResponseBody(body *MyGrantsTooManyRequestsResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListObjectsBadRequestResponseBody runs the validations defined on
+// list-objects_BadRequest_response_body
+func ValidateListObjectsBadRequestResponseBody(body *ListObjectsBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListObjectsUnauthorizedResponseBody runs the validations defined on
+// list-objects_Unauthorized_response_body
+func ValidateListObjectsUnauthorizedResponseBody(body *ListObjectsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListObjectsInternalServerErrorResponseBody runs the validations
+// defined on list-objects_InternalServerError_response_body
+func ValidateListObjectsInternalServerErrorResponseBody(body *ListObjectsInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-
-	return v
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewMyGrantsResultOK builds a "access-svc" service "my-grants" endpoint
-// result from a HTTP "OK" response.
-func NewMyGrantsResultOK(body *MyGrantsResponseBody) *accesssvc.MyGrantsResult {
-	v := &accesssvc.MyGrantsResult{}
-	v.Grants = make([]string, len(body.Grants))
-	for i, val := range body.Grants {
-		v.Grants[i] = val
+// ValidateListObjectsServiceUnavailableResponseBody runs the validations
+// defined on list-objects_ServiceUnavailable_response_body
+func ValidateListObjectsServiceUnavailableResponseBody(body *ListObjectsServiceUnavailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewMyGrantsBadRequest builds a access-svc service my-grants endpoint
-// BadRequest error.
-func NewMyGrantsBadRequest(body *MyGrantsBadRequestResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListObjectsGatewayTimeoutResponseBody runs the validations defined
+// on list-objects_GatewayTimeout_response_body
+func ValidateListObjectsGatewayTimeoutResponseBody(body *ListObjectsGatewayTimeoutResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewMyGrantsUnauthorized builds a access-svc service my-grants endpoint
-// Unauthorized error.
-func NewMyGrantsUnauthorized(body *MyGrantsUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListObjectsTooManyRequestsResponseBody runs the validations defined
+// on list-objects_TooManyRequests_response_body
+func ValidateListObjectsTooManyRequestsResponseBody(body *ListObjectsTooManyRequestsResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewMyGrantsInternalServerError builds a access-svc service my-grants
-// endpoint InternalServerError error.
-func NewMyGrantsInternalServerError(body *MyGrantsInternalServerErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListUsersBadRequestResponseBody runs the validations defined on
+// list-users_BadRequest_response_body
+func ValidateListUsersBadRequestResponseBody(body *ListUsersBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewMyGrantsServiceUnavailable builds a access-svc service my-grants endpoint
-// ServiceUnavailable error.
-func NewMyGrantsServiceUnavailable(body *MyGrantsServiceUnavailableResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListUsersUnauthorizedResponseBody runs the validations defined on
+// list-users_Unauthorized_response_body
+func ValidateListUsersUnauthorizedResponseBody(body *ListUsersUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewReadyzNotReady builds a access-svc service readyz endpoint NotReady error.
-func NewReadyzNotReady(body *ReadyzNotReadyResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListUsersForbiddenResponseBody runs the validations defined on
+// list-users_Forbidden_response_body
+func ValidateListUsersForbiddenResponseBody(body *ListUsersForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// ValidateCheckAccessResponseBody runs the validations defined on
-// Check-AccessResponseBody
-func ValidateCheckAccessResponseBody(body *CheckAccessResponseBody) (err error) {
-	if body.Results == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("results", "body"))
+// ValidateListUsersInternalServerErrorResponseBody runs the validations
+// defined on list-users_InternalServerError_response_body
+func ValidateListUsersInternalServerErrorResponseBody(body *ListUsersInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateMyGrantsResponseBody runs the validations defined on
-// My-GrantsResponseBody
-func ValidateMyGrantsResponseBody(body *MyGrantsResponseBody) (err error) {
-	if body.Grants == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("grants", "body"))
+// ValidateListUsersServiceUnavailableResponseBody runs the validations defined
+// on list-users_ServiceUnavailable_response_body
+func ValidateListUsersServiceUnavailableResponseBody(body *ListUsersServiceUnavailableResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateCheckAccessBadRequestResponseBody runs the validations defined on
-// check-access_BadRequest_response_body
-func ValidateCheckAccessBadRequestResponseBody(body *CheckAccessBadRequestResponseBody) (err error) {
+// ValidateListUsersGatewayTimeoutResponseBody runs the validations defined on
+// list-users_GatewayTimeout_response_body
+func ValidateListUsersGatewayTimeoutResponseBody(body *ListUsersGatewayTimeoutResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -415,9 +1975,9 @@ func ValidateCheckAccessBadRequestResponseBody(body *CheckAccessBadRequestRespon
 	return
 }
 
-// ValidateCheckAccessUnauthorizedResponseBody runs the validations defined on
-// check-access_Unauthorized_response_body
-func ValidateCheckAccessUnauthorizedResponseBody(body *CheckAccessUnauthorizedResponseBody) (err error) {
+// ValidateListUsersTooManyRequestsResponseBody runs the validations defined on
+// list-users_TooManyRequests_response_body
+func ValidateListUsersTooManyRequestsResponseBody(body *ListUsersTooManyRequestsResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -439,9 +1999,9 @@ func ValidateCheckAccessUnauthorizedResponseBody(body *CheckAccessUnauthorizedRe
 	return
 }
 
-// ValidateCheckAccessInternalServerErrorResponseBody runs the validations
-// defined on check-access_InternalServerError_response_body
-func ValidateCheckAccessInternalServerErrorResponseBody(body *CheckAccessInternalServerErrorResponseBody) (err error) {
+// ValidateMyPermissionsBadRequestResponseBody runs the validations defined on
+// my-permissions_BadRequest_response_body
+func ValidateMyPermissionsBadRequestResponseBody(body *MyPermissionsBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -463,9 +2023,9 @@ func ValidateCheckAccessInternalServerErrorResponseBody(body *CheckAccessInterna
 	return
 }
 
-// ValidateCheckAccessServiceUnavailableResponseBody runs the validations
-// defined on check-access_ServiceUnavailable_response_body
-func ValidateCheckAccessServiceUnavailableResponseBody(body *CheckAccessServiceUnavailableResponseBody) (err error) {
+// ValidateMyPermissionsUnauthorizedResponseBody runs the validations defined
+// on my-permissions_Unauthorized_response_body
+func ValidateMyPermissionsUnauthorizedResponseBody(body *MyPermissionsUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -487,9 +2047,9 @@ func ValidateCheckAccessServiceUnavailableResponseBody(body *CheckAccessServiceU
 	return
 }
 
-// ValidateMyGrantsBadRequestResponseBody runs the validations defined on
-// my-grants_BadRequest_response_body
-func ValidateMyGrantsBadRequestResponseBody(body *MyGrantsBadRequestResponseBody) (err error) {
+// ValidateMyPermissionsInternalServerErrorResponseBody runs the validations
+// defined on my-permissions_InternalServerError_response_body
+func ValidateMyPermissionsInternalServerErrorResponseBody(body *MyPermissionsInternalServerErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -511,9 +2071,9 @@ func ValidateMyGrantsBadRequestResponseBody(body *MyGrantsBadRequestResponseBody
 	return
 }
 
-// ValidateMyGrantsUnauthorizedResponseBody runs the validations defined on
-// my-grants_Unauthorized_response_body
-func ValidateMyGrantsUnauthorizedResponseBody(body *MyGrantsUnauthorizedResponseBody) (err error) {
+// ValidateMyPermissionsServiceUnavailableResponseBody runs the validations
+// defined on my-permissions_ServiceUnavailable_response_body
+func ValidateMyPermissionsServiceUnavailableResponseBody(body *MyPermissionsServiceUnavailableResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -535,9 +2095,9 @@ func ValidateMyGrantsUnauthorizedResponseBody(body *MyGrantsUnauthorizedResponse
 	return
 }
 
-// ValidateMyGrantsInternalServerErrorResponseBody runs the validations defined
-// on my-grants_InternalServerError_response_body
-func ValidateMyGrantsInternalServerErrorResponseBody(body *MyGrantsInternalServerErrorResponseBody) (err error) {
+// ValidateMyPermissionsGatewayTimeoutResponseBody runs the validations defined
+// on my-permissions_GatewayTimeout_response_body
+func ValidateMyPermissionsGatewayTimeoutResponseBody(body *MyPermissionsGatewayTimeoutResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -559,9 +2119,9 @@ func ValidateMyGrantsInternalServerErrorResponseBody(body *MyGrantsInternalServe
 	return
 }
 
-// ValidateMyGrantsServiceUnavailableResponseBody runs the validations defined
-// on my-grants_ServiceUnavailable_response_body
-func ValidateMyGrantsServiceUnavailableResponseBody(body *MyGrantsServiceUnavailableResponseBody) (err error) {
+// ValidateMyPermissionsTooManyRequestsResponseBody runs the validations
+// defined on my-permissions_TooManyRequests_response_body
+func ValidateMyPermissionsTooManyRequestsResponseBody(body *MyPermissionsTooManyRequestsResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -606,3 +2166,35 @@ func ValidateReadyzNotReadyResponseBody(body *ReadyzNotReadyResponseBody) (err e
 	}
 	return
 }
+
+// ValidateContextualTupleRequestBody runs the validations defined on
+// ContextualTupleRequestBody
+func ValidateContextualTupleRequestBody(body *ContextualTupleRequestBody) (err error) {
+	err = goa.MergeErrors(err, goa.ValidatePattern("body.object", body.Object, "^[a-z][a-z0-9_]*:[A-Za-z0-9][A-Za-z0-9._~|+=-]*$"))
+	if utf8.RuneCountInString(body.Object) > 256 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.object", body.Object, utf8.RuneCountInString(body.Object), 256, false))
+	}
+	err = goa.MergeErrors(err, goa.ValidatePattern("body.relation", body.Relation, "^[a-z][a-z0-9_]*$"))
+	if utf8.RuneCountInString(body.Relation) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.relation", body.Relation, utf8.RuneCountInString(body.Relation), 50, false))
+	}
+	return
+}
+
+// ValidateAccessCheckDecisionResponseBody runs the validations defined on
+// AccessCheckDecisionResponseBody
+func ValidateAccessCheckDecisionResponseBody(body *AccessCheckDecisionResponseBody) (err error) {
+	if body.Object == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("object", "body"))
+	}
+	if body.Relation == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("relation", "body"))
+	}
+	if body.User == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user", "body"))
+	}
+	if body.Allowed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("allowed", "body"))
+	}
+	return
+}
@@ -12,9 +12,12 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	accesssvc "github.com/linuxfoundation/lfx-v2-access-check/gen/access_svc"
+	accesssvcviews "github.com/linuxfoundation/lfx-v2-access-check/gen/access_svc/views"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -23,9 +26,16 @@ import (
 // access-svc check-access endpoint.
 func EncodeCheckAccessResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*accesssvc.CheckAccessResult)
+		res := v.(*accesssvcviews.CheckAccessResult)
+		w.Header().Set("goa-view", res.View)
 		enc := encoder(ctx, w)
-		body := NewCheckAccessResponseBody(res)
+		var body any
+		switch res.View {
+		case "default", "":
+			body = NewCheckAccessResponseBody(res.Projected)
+		case "v2":
+			body = NewCheckAccessResponseBodyV2(res.Projected)
+		}
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
@@ -64,8 +74,8 @@ func DecodeCheckAccessRequest(mux goahttp.Muxer, decoder func(*http.Request) goa
 		if version == "" {
 			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
 		}
-		if !(version == "1") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		if !(version == "1" || version == "2") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1", "2"}))
 		}
 		bearerToken = r.Header.Get("Authorization")
 		if bearerToken == "" {
@@ -121,6 +131,19 @@ func EncodeCheckAccessError(encoder func(context.Context, http.ResponseWriter) g
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusUnauthorized)
 			return enc.Encode(body)
+		case "Forbidden":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCheckAccessForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
 		case "InternalServerError":
 			var res *goa.ServiceError
 			errors.As(v, &res)
@@ -147,6 +170,32 @@ func EncodeCheckAccessError(encoder func(context.Context, http.ResponseWriter) g
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
 			return enc.Encode(body)
+		case "GatewayTimeout":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCheckAccessGatewayTimeoutResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusGatewayTimeout)
+			return enc.Encode(body)
+		case "TooManyRequests":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCheckAccessTooManyRequestsResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusTooManyRequests)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -171,10 +220,13 @@ func DecodeMyGrantsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 	return func(r *http.Request) (*accesssvc.MyGrantsPayload, error) {
 		var payload *accesssvc.MyGrantsPayload
 		var (
-			version     string
-			objectType  string
-			bearerToken string
-			err         error
+			version           string
+			objectType        []string
+			relation          *string
+			pageSize          int
+			continuationToken *string
+			bearerToken       string
+			err               error
 		)
 		qp := r.URL.Query()
 		version = qp.Get("v")
@@ -184,11 +236,58 @@ func DecodeMyGrantsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 		if !(version == "1") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
 		}
-		objectType = qp.Get("object_type")
-		if objectType == "" {
+		objectType = qp["object_type"]
+		if objectType == nil {
 			err = goa.MergeErrors(err, goa.MissingFieldError("object_type", "query string"))
 		}
-		err = goa.MergeErrors(err, goa.ValidatePattern("object_type", objectType, "^[a-z]+(_[a-z]+)*$"))
+		if len(objectType) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("object_type", objectType, len(objectType), 1, true))
+		}
+		if len(objectType) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("object_type", objectType, len(objectType), 20, false))
+		}
+		for _, e := range objectType {
+			err = goa.MergeErrors(err, goa.ValidatePattern("object_type[*]", e, "^[a-z][a-z0-9_]*$"))
+		}
+		relationRaw := qp.Get("relation")
+		if relationRaw != "" {
+			relation = &relationRaw
+		}
+		if relation != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("relation", *relation, "^[a-z][a-z0-9_]*$"))
+		}
+		if relation != nil {
+			if utf8.RuneCountInString(*relation) > 50 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("relation", *relation, utf8.RuneCountInString(*relation), 50, false))
+			}
+		}
+		{
+			pageSizeRaw := qp.Get("page_size")
+			if pageSizeRaw == "" {
+				pageSize = 100
+			} else {
+				v, err2 := strconv.ParseInt(pageSizeRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("page_size", pageSizeRaw, "integer"))
+				}
+				pageSize = int(v)
+			}
+		}
+		if pageSize < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+		}
+		if pageSize > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1000, false))
+		}
+		continuationTokenRaw := qp.Get("continuation_token")
+		if continuationTokenRaw != "" {
+			continuationToken = &continuationTokenRaw
+		}
+		if continuationToken != nil {
+			if utf8.RuneCountInString(*continuationToken) > 1024 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("continuation_token", *continuationToken, utf8.RuneCountInString(*continuationToken), 1024, false))
+			}
+		}
 		bearerToken = r.Header.Get("Authorization")
 		if bearerToken == "" {
 			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
@@ -196,7 +295,7 @@ func DecodeMyGrantsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 		if err != nil {
 			return payload, err
 		}
-		payload = NewMyGrantsPayload(version, objectType, bearerToken)
+		payload = NewMyGrantsPayload(version, objectType, relation, pageSize, continuationToken, bearerToken)
 		if strings.Contains(payload.BearerToken, " ") {
 			// Remove authorization scheme prefix (e.g. "Bearer")
 			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
@@ -269,6 +368,513 @@ func EncodeMyGrantsError(encoder func(context.Context, http.ResponseWriter) goah
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusServiceUnavailable)
 			return enc.Encode(body)
+		case "GatewayTimeout":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewMyGrantsGatewayTimeoutResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusGatewayTimeout)
+			return enc.Encode(body)
+		case "TooManyRequests":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewMyGrantsTooManyRequestsResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusTooManyRequests)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListObjectsResponse returns an encoder for responses returned by the
+// access-svc list-objects endpoint.
+func EncodeListObjectsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*accesssvc.ListObjectsResult)
+		enc := encoder(ctx, w)
+		body := NewListObjectsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListObjectsRequest returns a decoder for requests sent to the
+// access-svc list-objects endpoint.
+func DecodeListObjectsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*accesssvc.ListObjectsPayload, error) {
+	return func(r *http.Request) (*accesssvc.ListObjectsPayload, error) {
+		var payload *accesssvc.ListObjectsPayload
+		var (
+			version     string
+			objectType  string
+			relation    string
+			bearerToken string
+			err         error
+		)
+		qp := r.URL.Query()
+		version = qp.Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		objectType = qp.Get("object_type")
+		if objectType == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("object_type", "query string"))
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("object_type", objectType, "^[a-z][a-z0-9_]*$"))
+		relation = qp.Get("relation")
+		if relation == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("relation", "query string"))
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("relation", relation, "^[a-z][a-z0-9_]*$"))
+		if utf8.RuneCountInString(relation) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("relation", relation, utf8.RuneCountInString(relation), 50, false))
+		}
+		bearerToken = r.Header.Get("Authorization")
+		if bearerToken == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewListObjectsPayload(version, objectType, relation, bearerToken)
+		if strings.Contains(payload.BearerToken, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
+			payload.BearerToken = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListObjectsError returns an encoder for errors returned by the
+// list-objects access-svc endpoint.
+func EncodeListObjectsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListObjectsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListObjectsUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListObjectsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListObjectsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "GatewayTimeout":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListObjectsGatewayTimeoutResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusGatewayTimeout)
+			return enc.Encode(body)
+		case "TooManyRequests":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListObjectsTooManyRequestsResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusTooManyRequests)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListUsersResponse returns an encoder for responses returned by the
+// access-svc list-users endpoint.
+func EncodeListUsersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*accesssvc.ListUsersResult)
+		enc := encoder(ctx, w)
+		body := NewListUsersResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListUsersRequest returns a decoder for requests sent to the access-svc
+// list-users endpoint.
+func DecodeListUsersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*accesssvc.ListUsersPayload, error) {
+	return func(r *http.Request) (*accesssvc.ListUsersPayload, error) {
+		var payload *accesssvc.ListUsersPayload
+		var (
+			version     string
+			object      string
+			relation    string
+			bearerToken string
+			err         error
+		)
+		qp := r.URL.Query()
+		version = qp.Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		object = qp.Get("object")
+		if object == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("object", "query string"))
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("object", object, "^[a-z][a-z0-9_]*:[A-Za-z0-9][A-Za-z0-9._~|+=-]*$"))
+		if utf8.RuneCountInString(object) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("object", object, utf8.RuneCountInString(object), 256, false))
+		}
+		relation = qp.Get("relation")
+		if relation == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("relation", "query string"))
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("relation", relation, "^[a-z][a-z0-9_]*$"))
+		if utf8.RuneCountInString(relation) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("relation", relation, utf8.RuneCountInString(relation), 50, false))
+		}
+		bearerToken = r.Header.Get("Authorization")
+		if bearerToken == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewListUsersPayload(version, object, relation, bearerToken)
+		if strings.Contains(payload.BearerToken, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
+			payload.BearerToken = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListUsersError returns an encoder for errors returned by the
+// list-users access-svc endpoint.
+func EncodeListUsersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsersBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsersUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "Forbidden":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsersForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsersInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsersServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "GatewayTimeout":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsersGatewayTimeoutResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusGatewayTimeout)
+			return enc.Encode(body)
+		case "TooManyRequests":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsersTooManyRequestsResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusTooManyRequests)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeMyPermissionsResponse returns an encoder for responses returned by the
+// access-svc my-permissions endpoint.
+func EncodeMyPermissionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*accesssvc.MyPermissionsResult)
+		enc := encoder(ctx, w)
+		body := NewMyPermissionsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeMyPermissionsRequest returns a decoder for requests sent to the
+// access-svc my-permissions endpoint.
+func DecodeMyPermissionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*accesssvc.MyPermissionsPayload, error) {
+	return func(r *http.Request) (*accesssvc.MyPermissionsPayload, error) {
+		var payload *accesssvc.MyPermissionsPayload
+		var (
+			version     string
+			object      string
+			bearerToken string
+			err         error
+		)
+		qp := r.URL.Query()
+		version = qp.Get("v")
+		if version == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("version", "query string"))
+		}
+		if !(version == "1") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("version", version, []any{"1"}))
+		}
+		object = qp.Get("object")
+		if object == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("object", "query string"))
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("object", object, "^[a-z][a-z0-9_]*:[A-Za-z0-9][A-Za-z0-9._~|+=-]*$"))
+		if utf8.RuneCountInString(object) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("object", object, utf8.RuneCountInString(object), 256, false))
+		}
+		bearerToken = r.Header.Get("Authorization")
+		if bearerToken == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("bearer_token", "header"))
+		}
+		if err != nil {
+			return payload, err
+		}
+		payload = NewMyPermissionsPayload(version, object, bearerToken)
+		if strings.Contains(payload.BearerToken, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.BearerToken, " ", 2)[1]
+			payload.BearerToken = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeMyPermissionsError returns an encoder for errors returned by the
+// my-permissions access-svc endpoint.
+func EncodeMyPermissionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "BadRequest":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewMyPermissionsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "Unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewMyPermissionsUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "InternalServerError":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewMyPermissionsInternalServerErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "ServiceUnavailable":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewMyPermissionsServiceUnavailableResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "GatewayTimeout":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewMyPermissionsGatewayTimeoutResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusGatewayTimeout)
+			return enc.Encode(body)
+		case "TooManyRequests":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewMyPermissionsTooManyRequestsResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusTooManyRequests)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -329,3 +935,38 @@ func EncodeLivezResponse(encoder func(context.Context, http.ResponseWriter) goah
 		return enc.Encode(body)
 	}
 }
+
+// unmarshalContextualTupleRequestBodyToAccesssvcContextualTuple builds a value
+// of type *accesssvc.ContextualTuple from a value of type
+// *ContextualTupleRequestBody.
+func unmarshalContextualTupleRequestBodyToAccesssvcContextualTuple(v *ContextualTupleRequestBody) *accesssvc.ContextualTuple {
+	if v == nil {
+		return nil
+	}
+	res := &accesssvc.ContextualTuple{
+		Object:   *v.Object,
+		Relation: *v.Relation,
+		User:     *v.User,
+	}
+
+	return res
+}
+
+// marshalAccesssvcviewsAccessCheckDecisionViewToAccessCheckDecisionResponseBody
+// builds a value of type *AccessCheckDecisionResponseBody from a value of type
+// *accesssvcviews.AccessCheckDecisionView.
+func marshalAccesssvcviewsAccessCheckDecisionViewToAccessCheckDecisionResponseBody(v *accesssvcviews.AccessCheckDecisionView) *AccessCheckDecisionResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &AccessCheckDecisionResponseBody{
+		Object:     *v.Object,
+		Relation:   *v.Relation,
+		User:       *v.User,
+		Allowed:    *v.Allowed,
+		Stale:      v.Stale,
+		Contextual: v.Contextual,
+	}
+
+	return res
+}
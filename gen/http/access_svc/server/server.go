@@ -22,6 +22,9 @@ type Server struct {
 	Mounts              []*MountPoint
 	CheckAccess         http.Handler
 	MyGrants            http.Handler
+	ListObjects         http.Handler
+	ListUsers           http.Handler
+	MyPermissions       http.Handler
 	Readyz              http.Handler
 	Livez               http.Handler
 	GenHTTPOpenapiJSON  http.Handler
@@ -79,6 +82,9 @@ func New(
 		Mounts: []*MountPoint{
 			{"CheckAccess", "POST", "/access-check"},
 			{"MyGrants", "GET", "/my-grants"},
+			{"ListObjects", "GET", "/my-objects"},
+			{"ListUsers", "GET", "/object-users"},
+			{"MyPermissions", "GET", "/my-permissions"},
 			{"Readyz", "GET", "/readyz"},
 			{"Livez", "GET", "/livez"},
 			{"Serve gen/http/openapi.json", "GET", "/_access-check/openapi.json"},
@@ -88,6 +94,9 @@ func New(
 		},
 		CheckAccess:         NewCheckAccessHandler(e.CheckAccess, mux, decoder, encoder, errhandler, formatter),
 		MyGrants:            NewMyGrantsHandler(e.MyGrants, mux, decoder, encoder, errhandler, formatter),
+		ListObjects:         NewListObjectsHandler(e.ListObjects, mux, decoder, encoder, errhandler, formatter),
+		ListUsers:           NewListUsersHandler(e.ListUsers, mux, decoder, encoder, errhandler, formatter),
+		MyPermissions:       NewMyPermissionsHandler(e.MyPermissions, mux, decoder, encoder, errhandler, formatter),
 		Readyz:              NewReadyzHandler(e.Readyz, mux, decoder, encoder, errhandler, formatter),
 		Livez:               NewLivezHandler(e.Livez, mux, decoder, encoder, errhandler, formatter),
 		GenHTTPOpenapiJSON:  http.FileServer(fileSystemGenHTTPOpenapiJSON),
@@ -104,6 +113,9 @@ func (s *Server) Service() string { return "access-svc" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.CheckAccess = m(s.CheckAccess)
 	s.MyGrants = m(s.MyGrants)
+	s.ListObjects = m(s.ListObjects)
+	s.ListUsers = m(s.ListUsers)
+	s.MyPermissions = m(s.MyPermissions)
 	s.Readyz = m(s.Readyz)
 	s.Livez = m(s.Livez)
 }
@@ -115,6 +127,9 @@ func (s *Server) MethodNames() []string { return accesssvc.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountCheckAccessHandler(mux, h.CheckAccess)
 	MountMyGrantsHandler(mux, h.MyGrants)
+	MountListObjectsHandler(mux, h.ListObjects)
+	MountListUsersHandler(mux, h.ListUsers)
+	MountMyPermissionsHandler(mux, h.MyPermissions)
 	MountReadyzHandler(mux, h.Readyz)
 	MountLivezHandler(mux, h.Livez)
 	MountGenHTTPOpenapiJSON(mux, http.StripPrefix("/_access-check", h.GenHTTPOpenapiJSON))
@@ -234,6 +249,165 @@ func NewMyGrantsHandler(
 	})
 }
 
+// MountListObjectsHandler configures the mux to serve the "access-svc" service
+// "list-objects" endpoint.
+func MountListObjectsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/my-objects", f)
+}
+
+// NewListObjectsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "access-svc" service "list-objects" endpoint.
+func NewListObjectsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListObjectsRequest(mux, decoder)
+		encodeResponse = EncodeListObjectsResponse(encoder)
+		encodeError    = EncodeListObjectsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-objects")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "access-svc")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListUsersHandler configures the mux to serve the "access-svc" service
+// "list-users" endpoint.
+func MountListUsersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/object-users", f)
+}
+
+// NewListUsersHandler creates a HTTP handler which loads the HTTP request and
+// calls the "access-svc" service "list-users" endpoint.
+func NewListUsersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListUsersRequest(mux, decoder)
+		encodeResponse = EncodeListUsersResponse(encoder)
+		encodeError    = EncodeListUsersError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-users")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "access-svc")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountMyPermissionsHandler configures the mux to serve the "access-svc"
+// service "my-permissions" endpoint.
+func MountMyPermissionsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/my-permissions", f)
+}
+
+// NewMyPermissionsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "access-svc" service "my-permissions" endpoint.
+func NewMyPermissionsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeMyPermissionsRequest(mux, decoder)
+		encodeResponse = EncodeMyPermissionsResponse(encoder)
+		encodeError    = EncodeMyPermissionsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "my-permissions")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "access-svc")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountReadyzHandler configures the mux to serve the "access-svc" service
 // "readyz" endpoint.
 func MountReadyzHandler(mux goahttp.Muxer, h http.Handler) {
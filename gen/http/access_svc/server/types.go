@@ -8,30 +8,76 @@
 package server
 
 import (
+	"unicode/utf8"
+
 	accesssvc "github.com/linuxfoundation/lfx-v2-access-check/gen/access_svc"
+	accesssvcviews "github.com/linuxfoundation/lfx-v2-access-check/gen/access_svc/views"
 	goa "goa.design/goa/v3/pkg"
 )
 
 // CheckAccessRequestBody is the type of the "access-svc" service
 // "check-access" endpoint HTTP request body.
 type CheckAccessRequestBody struct {
-	// Resource-action pairs to check
+	// Resource-action pairs to check, each in strict 'type:id#relation' form; at
+	// most 1000 per call
 	Requests []string `form:"requests,omitempty" json:"requests,omitempty" xml:"requests,omitempty"`
+	// Tuples considered in addition to stored tuples for every check in this call;
+	// only accepted from allowed clients, and never for the checked user
+	ContextualTuples []*ContextualTupleRequestBody `form:"contextual_tuples,omitempty" json:"contextual_tuples,omitempty" xml:"contextual_tuples,omitempty"`
+	// Condition context for every check in this call, e.g. the current time or
+	// client IP; only accepted from allowed clients
+	Context map[string]any `form:"context,omitempty" json:"context,omitempty" xml:"context,omitempty"`
 }
 
 // CheckAccessResponseBody is the type of the "access-svc" service
 // "check-access" endpoint HTTP response body.
 type CheckAccessResponseBody struct {
-	// Access check results — each entry is 'object#relation@user\ttrue' or
+	// Access check results (v1) — each entry is 'object#relation@user\ttrue' or
 	// 'object#relation@user\tfalse'
 	Results []string `form:"results" json:"results" xml:"results"`
 }
 
+// CheckAccessResponseBodyV2 is the type of the "access-svc" service
+// "check-access" endpoint HTTP response body.
+type CheckAccessResponseBodyV2 struct {
+	// Structured access check results (v2)
+	Decisions []*AccessCheckDecisionResponseBody `form:"decisions,omitempty" json:"decisions,omitempty" xml:"decisions,omitempty"`
+}
+
 // MyGrantsResponseBody is the type of the "access-svc" service "my-grants"
 // endpoint HTTP response body.
 type MyGrantsResponseBody struct {
-	// Direct access grants as tuple-strings
+	// Direct access grants as tuple-strings, grouped in requested object type order
 	Grants []string `form:"grants" json:"grants" xml:"grants"`
+	// Direct access grants keyed by object type; every requested type is present
+	GrantsByType map[string][]string `form:"grants_by_type" json:"grants_by_type" xml:"grants_by_type"`
+	// Opaque token to fetch the next page; absent on the last page
+	ContinuationToken *string `form:"continuation_token,omitempty" json:"continuation_token,omitempty" xml:"continuation_token,omitempty"`
+}
+
+// ListObjectsResponseBody is the type of the "access-svc" service
+// "list-objects" endpoint HTTP response body.
+type ListObjectsResponseBody struct {
+	// Objects, in 'type:id' form, on which the caller holds the relation
+	Objects []string `form:"objects" json:"objects" xml:"objects"`
+}
+
+// ListUsersResponseBody is the type of the "access-svc" service "list-users"
+// endpoint HTTP response body.
+type ListUsersResponseBody struct {
+	// Users holding the relation
+	Users []string `form:"users" json:"users" xml:"users"`
+	// Usersets holding the relation
+	Usersets []string `form:"usersets" json:"usersets" xml:"usersets"`
+}
+
+// MyPermissionsResponseBody is the type of the "access-svc" service
+// "my-permissions" endpoint HTTP response body.
+type MyPermissionsResponseBody struct {
+	// Object the relations were checked on
+	Object string `form:"object" json:"object" xml:"object"`
+	// Relations the caller holds on the object, in permission model order
+	Relations []string `form:"relations" json:"relations" xml:"relations"`
 }
 
 // CheckAccessBadRequestResponseBody is the type of the "access-svc" service
@@ -70,6 +116,24 @@ type CheckAccessUnauthorizedResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// CheckAccessForbiddenResponseBody is the type of the "access-svc" service
+// "check-access" endpoint HTTP response body for the "Forbidden" error.
+type CheckAccessForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // CheckAccessInternalServerErrorResponseBody is the type of the "access-svc"
 // service "check-access" endpoint HTTP response body for the
 // "InternalServerError" error.
@@ -108,6 +172,44 @@ type CheckAccessServiceUnavailableResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// CheckAccessGatewayTimeoutResponseBody is the type of the "access-svc"
+// service "check-access" endpoint HTTP response body for the "GatewayTimeout"
+// error.
+type CheckAccessGatewayTimeoutResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CheckAccessTooManyRequestsResponseBody is the type of the "access-svc"
+// service "check-access" endpoint HTTP response body for the "TooManyRequests"
+// error.
+type CheckAccessTooManyRequestsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // MyGrantsBadRequestResponseBody is the type of the "access-svc" service
 // "my-grants" endpoint HTTP response body for the "BadRequest" error.
 type MyGrantsBadRequestResponseBody struct {
@@ -182,9 +284,9 @@ type MyGrantsServiceUnavailableResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ReadyzNotReadyResponseBody is the type of the "access-svc" service "readyz"
-// endpoint HTTP response body for the "NotReady" error.
-type ReadyzNotReadyResponseBody struct {
+// MyGrantsGatewayTimeoutResponseBody is the type of the "access-svc" service
+// "my-grants" endpoint HTTP response body for the "GatewayTimeout" error.
+type MyGrantsGatewayTimeoutResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -200,122 +302,668 @@ type ReadyzNotReadyResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCheckAccessResponseBody builds the HTTP response body from the result of
-// the "check-access" endpoint of the "access-svc" service.
-func NewCheckAccessResponseBody(res *accesssvc.CheckAccessResult) *CheckAccessResponseBody {
-	body := &CheckAccessResponseBody{}
-	if res.Results != nil {
-		body.Results = make([]string, len(res.Results))
-		for i, val := range res.Results {
-			body.Results[i] = val
-		}
-	} else {
-		body.Results = []string{}
-	}
-	return body
-}
-
-// NewMyGrantsResponseBody builds the HTTP response body from the result of the
-// "my-grants" endpoint of the "access-svc" service.
-func NewMyGrantsResponseBody(res *accesssvc.MyGrantsResult) *MyGrantsResponseBody {
-	body := &MyGrantsResponseBody{}
-	if res.Grants != nil {
-		body.Grants = make([]string, len(res.Grants))
-		for i, val := range res.Grants {
-			body.Grants[i] = val
-		}
-	} else {
-		body.Grants = []string{}
-	}
-	return body
+// MyGrantsTooManyRequestsResponseBody is the type of the "access-svc" service
+// "my-grants" endpoint HTTP response body for the "TooManyRequests" error.
+type MyGrantsTooManyRequestsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCheckAccessBadRequestResponseBody builds the HTTP response body from the
-// result of the "check-access" endpoint of the "access-svc" service.
-func NewCheckAccessBadRequestResponseBody(res *goa.ServiceError) *CheckAccessBadRequestResponseBody {
-	body := &CheckAccessBadRequestResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// ListObjectsBadRequestResponseBody is the type of the "access-svc" service
+// "list-objects" endpoint HTTP response body for the "BadRequest" error.
+type ListObjectsBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCheckAccessUnauthorizedResponseBody builds the HTTP response body from
-// the result of the "check-access" endpoint of the "access-svc" service.
-func NewCheckAccessUnauthorizedResponseBody(res *goa.ServiceError) *CheckAccessUnauthorizedResponseBody {
-	body := &CheckAccessUnauthorizedResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// ListObjectsUnauthorizedResponseBody is the type of the "access-svc" service
+// "list-objects" endpoint HTTP response body for the "Unauthorized" error.
+type ListObjectsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCheckAccessInternalServerErrorResponseBody builds the HTTP response body
-// from the result of the "check-access" endpoint of the "access-svc" service.
-func NewCheckAccessInternalServerErrorResponseBody(res *goa.ServiceError) *CheckAccessInternalServerErrorResponseBody {
-	body := &CheckAccessInternalServerErrorResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// ListObjectsInternalServerErrorResponseBody is the type of the "access-svc"
+// service "list-objects" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListObjectsInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCheckAccessServiceUnavailableResponseBody builds the HTTP response body
-// from the result of the "check-access" endpoint of the "access-svc" service.
-func NewCheckAccessServiceUnavailableResponseBody(res *goa.ServiceError) *CheckAccessServiceUnavailableResponseBody {
-	body := &CheckAccessServiceUnavailableResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// ListObjectsServiceUnavailableResponseBody is the type of the "access-svc"
+// service "list-objects" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ListObjectsServiceUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewMyGrantsBadRequestResponseBody builds the HTTP response body from the
-// result of the "my-grants" endpoint of the "access-svc" service.
-func NewMyGrantsBadRequestResponseBody(res *goa.ServiceError) *MyGrantsBadRequestResponseBody {
-	body := &MyGrantsBadRequestResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// ListObjectsGatewayTimeoutResponseBody is the type of the "access-svc"
+// service "list-objects" endpoint HTTP response body for the "GatewayTimeout"
+// error.
+type ListObjectsGatewayTimeoutResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewMyGrantsUnauthorizedResponseBody builds the HTTP response body from the
-// result of the "my-grants" endpoint of the "access-svc" service.
-func NewMyGrantsUnauthorizedResponseBody(res *goa.ServiceError) *MyGrantsUnauthorizedResponseBody {
-	body := &MyGrantsUnauthorizedResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// ListObjectsTooManyRequestsResponseBody is the type of the "access-svc"
+// service "list-objects" endpoint HTTP response body for the "TooManyRequests"
+// error.
+type ListObjectsTooManyRequestsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewMyGrantsInternalServerErrorResponseBody builds the HTTP response body
-// from the result of the "my-grants" endpoint of the "access-svc" service.
+// ListUsersBadRequestResponseBody is the type of the "access-svc" service
+// "list-users" endpoint HTTP response body for the "BadRequest" error.
+type ListUsersBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListUsersUnauthorizedResponseBody is the type of the "access-svc" service
+// "list-users" endpoint HTTP response body for the "Unauthorized" error.
+type ListUsersUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListUsersForbiddenResponseBody is the type of the "access-svc" service
+// "list-users" endpoint HTTP response body for the "Forbidden" error.
+type ListUsersForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListUsersInternalServerErrorResponseBody is the type of the "access-svc"
+// service "list-users" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListUsersInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListUsersServiceUnavailableResponseBody is the type of the "access-svc"
+// service "list-users" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ListUsersServiceUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListUsersGatewayTimeoutResponseBody is the type of the "access-svc" service
+// "list-users" endpoint HTTP response body for the "GatewayTimeout" error.
+type ListUsersGatewayTimeoutResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListUsersTooManyRequestsResponseBody is the type of the "access-svc" service
+// "list-users" endpoint HTTP response body for the "TooManyRequests" error.
+type ListUsersTooManyRequestsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// MyPermissionsBadRequestResponseBody is the type of the "access-svc" service
+// "my-permissions" endpoint HTTP response body for the "BadRequest" error.
+type MyPermissionsBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// MyPermissionsUnauthorizedResponseBody is the type of the "access-svc"
+// service "my-permissions" endpoint HTTP response body for the "Unauthorized"
+// error.
+type MyPermissionsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// MyPermissionsInternalServerErrorResponseBody is the type of the "access-svc"
+// service "my-permissions" endpoint HTTP response body for the
+// "InternalServerError" error.
+type MyPermissionsInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// MyPermissionsServiceUnavailableResponseBody is the type of the "access-svc"
+// service "my-permissions" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type MyPermissionsServiceUnavailableResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// MyPermissionsGatewayTimeoutResponseBody is the type of the "access-svc"
+// service "my-permissions" endpoint HTTP response body for the
+// "GatewayTimeout" error.
+type MyPermissionsGatewayTimeoutResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// MyPermissionsTooManyRequestsResponseBody is the type of the "access-svc"
+// service "my-permissions" endpoint HTTP response body for the
+// "TooManyRequests" error.
+type MyPermissionsTooManyRequestsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ReadyzNotReadyResponseBody is the type of the "access-svc" service "readyz"
+// endpoint HTTP response body for the "NotReady" error.
+type ReadyzNotReadyResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AccessCheckDecisionResponseBody is used to define fields on response body
+// types.
+type AccessCheckDecisionResponseBody struct {
+	// Object in type:id form
+	Object string `form:"object" json:"object" xml:"object"`
+	// Relation checked on the object
+	Relation string `form:"relation" json:"relation" xml:"relation"`
+	// User the relation was checked for
+	User string `form:"user" json:"user" xml:"user"`
+	// Whether the user holds the relation on the object
+	Allowed bool `form:"allowed" json:"allowed" xml:"allowed"`
+	// Set when fga-sync was unavailable and the decision came from an expired
+	// cache entry or failed closed
+	Stale *bool `form:"stale,omitempty" json:"stale,omitempty" xml:"stale,omitempty"`
+	// Set when the decision was made with the call's contextual tuples rather than
+	// stored tuples alone
+	Contextual *bool `form:"contextual,omitempty" json:"contextual,omitempty" xml:"contextual,omitempty"`
+}
+
+// ContextualTupleRequestBody is used to define fields on request body types.
+type ContextualTupleRequestBody struct {
+	// Object in type:id form
+	Object *string `form:"object,omitempty" json:"object,omitempty" xml:"object,omitempty"`
+	// Relation the user holds on the object
+	Relation *string `form:"relation,omitempty" json:"relation,omitempty" xml:"relation,omitempty"`
+	// Principal holding the relation; never the caller, a wildcard, a userset or
+	// an object
+	User *string `form:"user,omitempty" json:"user,omitempty" xml:"user,omitempty"`
+}
+
+// NewCheckAccessResponseBody builds the HTTP response body from the result of
+// the "check-access" endpoint of the "access-svc" service.
+func NewCheckAccessResponseBody(res *accesssvcviews.CheckAccessResultView) *CheckAccessResponseBody {
+	body := &CheckAccessResponseBody{}
+	if res.Results != nil {
+		body.Results = make([]string, len(res.Results))
+		for i, val := range res.Results {
+			body.Results[i] = val
+		}
+	} else {
+		body.Results = []string{}
+	}
+	return body
+}
+
+// NewCheckAccessResponseBodyV2 builds the HTTP response body from the result
+// of the "check-access" endpoint of the "access-svc" service.
+func NewCheckAccessResponseBodyV2(res *accesssvcviews.CheckAccessResultView) *CheckAccessResponseBodyV2 {
+	body := &CheckAccessResponseBodyV2{}
+	if res.Decisions != nil {
+		body.Decisions = make([]*AccessCheckDecisionResponseBody, len(res.Decisions))
+		for i, val := range res.Decisions {
+			if val == nil {
+				body.Decisions[i] = nil
+				continue
+			}
+			body.Decisions[i] = marshalAccesssvcviewsAccessCheckDecisionViewToAccessCheckDecisionResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewMyGrantsResponseBody builds the HTTP response body from the result of the
+// "my-grants" endpoint of the "access-svc" service.
+func NewMyGrantsResponseBody(res *accesssvc.MyGrantsResult) *MyGrantsResponseBody {
+	body := &MyGrantsResponseBody{
+		ContinuationToken: res.ContinuationToken,
+	}
+	if res.Grants != nil {
+		body.Grants = make([]string, len(res.Grants))
+		for i, val := range res.Grants {
+			body.Grants[i] = val
+		}
+	} else {
+		body.Grants = []string{}
+	}
+	if res.GrantsByType != nil {
+		body.GrantsByType = make(map[string][]string, len(res.GrantsByType))
+		for key, val := range res.GrantsByType {
+			tk := key
+			tv := make([]string, len(val))
+			for i, val := range val {
+				tv[i] = val
+			}
+			body.GrantsByType[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewListObjectsResponseBody builds the HTTP response body from the result of
+// the "list-objects" endpoint of the "access-svc" service.
+func NewListObjectsResponseBody(res *accesssvc.ListObjectsResult) *ListObjectsResponseBody {
+	body := &ListObjectsResponseBody{}
+	if res.Objects != nil {
+		body.Objects = make([]string, len(res.Objects))
+		for i, val := range res.Objects {
+			body.Objects[i] = val
+		}
+	} else {
+		body.Objects = []string{}
+	}
+	return body
+}
+
+// NewListUsersResponseBody builds the HTTP response body from the result of
+// the "list-users" endpoint of the "access-svc" service.
+func NewListUsersResponseBody(res *accesssvc.ListUsersResult) *ListUsersResponseBody {
+	body := &ListUsersResponseBody{}
+	if res.Users != nil {
+		body.Users = make([]string, len(res.Users))
+		for i, val := range res.Users {
+			body.Users[i] = val
+		}
+	} else {
+		body.Users = []string{}
+	}
+	if res.Usersets != nil {
+		body.Usersets = make([]string, len(res.Usersets))
+		for i, val := range res.Usersets {
+			body.Usersets[i] = val
+		}
+	} else {
+		body.Usersets = []string{}
+	}
+	return body
+}
+
+// NewMyPermissionsResponseBody builds the HTTP response body from the result
+// of the "my-permissions" endpoint of the "access-svc" service.
+func NewMyPermissionsResponseBody(res *accesssvc.MyPermissionsResult) *MyPermissionsResponseBody {
+	body := &MyPermissionsResponseBody{
+		Object: res.Object,
+	}
+	if res.Relations != nil {
+		body.Relations = make([]string, len(res.Relations))
+		for i, val := range res.Relations {
+			body.Relations[i] = val
+		}
+	} else {
+		body.Relations = []string{}
+	}
+	return body
+}
+
+// NewCheckAccessBadRequestResponseBody builds the HTTP response body from the
+// result of the "check-access" endpoint of the "access-svc" service.
+func NewCheckAccessBadRequestResponseBody(res *goa.ServiceError) *CheckAccessBadRequestResponseBody {
+	body := &CheckAccessBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCheckAccessUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "check-access" endpoint of the "access-svc" service.
+func NewCheckAccessUnauthorizedResponseBody(res *goa.ServiceError) *CheckAccessUnauthorizedResponseBody {
+	body := &CheckAccessUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCheckAccessForbiddenResponseBody builds the HTTP response body from the
+// result of the "check-access" endpoint of the "access-svc" service.
+func NewCheckAccessForbiddenResponseBody(res *goa.ServiceError) *CheckAccessForbiddenResponseBody {
+	body := &CheckAccessForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCheckAccessInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "check-access" endpoint of the "access-svc" service.
+func NewCheckAccessInternalServerErrorResponseBody(res *goa.ServiceError) *CheckAccessInternalServerErrorResponseBody {
+	body := &CheckAccessInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCheckAccessServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "check-access" endpoint of the "access-svc" service.
+func NewCheckAccessServiceUnavailableResponseBody(res *goa.ServiceError) *CheckAccessServiceUnavailableResponseBody {
+	body := &CheckAccessServiceUnavailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCheckAccessGatewayTimeoutResponseBody builds the HTTP response body from
+// the result of the "check-access" endpoint of the "access-svc" service.
+func NewCheckAccessGatewayTimeoutResponseBody(res *goa.ServiceError) *CheckAccessGatewayTimeoutResponseBody {
+	body := &CheckAccessGatewayTimeoutResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCheckAccessTooManyRequestsResponseBody builds the HTTP response body from
+// the result of the "check-access" endpoint of the "access-svc" service.
+func NewCheckAccessTooManyRequestsResponseBody(res *goa.ServiceError) *CheckAccessTooManyRequestsResponseBody {
+	body := &CheckAccessTooManyRequestsResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMyGrantsBadRequestResponseBody builds the HTTP response body from the
+// result of the "my-grants" endpoint of the "access-svc" service.
+func NewMyGrantsBadRequestResponseBody(res *goa.ServiceError) *MyGrantsBadRequestResponseBody {
+	body := &MyGrantsBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMyGrantsUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "my-grants" endpoint of the "access-svc" service.
+func NewMyGrantsUnauthorizedResponseBody(res *goa.ServiceError) *MyGrantsUnauthorizedResponseBody {
+	body := &MyGrantsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMyGrantsInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "my-grants" endpoint of the "access-svc" service.
 func NewMyGrantsInternalServerErrorResponseBody(res *goa.ServiceError) *MyGrantsInternalServerErrorResponseBody {
 	body := &MyGrantsInternalServerErrorResponseBody{
 		Name:      res.Name,
@@ -342,6 +990,301 @@ func NewMyGrantsServiceUnavailableResponseBody(res *goa.ServiceError) *MyGrantsS
 	return body
 }
 
+// NewMyGrantsGatewayTimeoutResponseBody builds the HTTP response body from the
+// result of the "my-grants" endpoint of the "access-svc" service.
+func NewMyGrantsGatewayTimeoutResponseBody(res *goa.ServiceError) *MyGrantsGatewayTimeoutResponseBody {
+	body := &MyGrantsGatewayTimeoutResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMyGrantsTooManyRequestsResponseBody builds the HTTP response body from
+// the result of the "my-grants" endpoint of the "access-svc" service.
+func NewMyGrantsTooManyRequestsResponseBody(res *goa.ServiceError) *MyGrantsTooManyRequestsResponseBody {
+	body := &MyGrantsTooManyRequestsResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListObjectsBadRequestResponseBody builds the HTTP response body from the
+// result of the "list-objects" endpoint of the "access-svc" service.
+func NewListObjectsBadRequestResponseBody(res *goa.ServiceError) *ListObjectsBadRequestResponseBody {
+	body := &ListObjectsBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListObjectsUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "list-objects" endpoint of the "access-svc" service.
+func NewListObjectsUnauthorizedResponseBody(res *goa.ServiceError) *ListObjectsUnauthorizedResponseBody {
+	body := &ListObjectsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListObjectsInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "list-objects" endpoint of the "access-svc" service.
+func NewListObjectsInternalServerErrorResponseBody(res *goa.ServiceError) *ListObjectsInternalServerErrorResponseBody {
+	body := &ListObjectsInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListObjectsServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "list-objects" endpoint of the "access-svc" service.
+func NewListObjectsServiceUnavailableResponseBody(res *goa.ServiceError) *ListObjectsServiceUnavailableResponseBody {
+	body := &ListObjectsServiceUnavailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListObjectsGatewayTimeoutResponseBody builds the HTTP response body from
+// the result of the "list-objects" endpoint of the "access-svc" service.
+func NewListObjectsGatewayTimeoutResponseBody(res *goa.ServiceError) *ListObjectsGatewayTimeoutResponseBody {
+	body := &ListObjectsGatewayTimeoutResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListObjectsTooManyRequestsResponseBody builds the HTTP response body from
+// the result of the "list-objects" endpoint of the "access-svc" service.
+func NewListObjectsTooManyRequestsResponseBody(res *goa.ServiceError) *ListObjectsTooManyRequestsResponseBody {
+	body := &ListObjectsTooManyRequestsResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsersBadRequestResponseBody builds the HTTP response body from the
+// result of the "list-users" endpoint of the "access-svc" service.
+func NewListUsersBadRequestResponseBody(res *goa.ServiceError) *ListUsersBadRequestResponseBody {
+	body := &ListUsersBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsersUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "list-users" endpoint of the "access-svc" service.
+func NewListUsersUnauthorizedResponseBody(res *goa.ServiceError) *ListUsersUnauthorizedResponseBody {
+	body := &ListUsersUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsersForbiddenResponseBody builds the HTTP response body from the
+// result of the "list-users" endpoint of the "access-svc" service.
+func NewListUsersForbiddenResponseBody(res *goa.ServiceError) *ListUsersForbiddenResponseBody {
+	body := &ListUsersForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsersInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "list-users" endpoint of the "access-svc" service.
+func NewListUsersInternalServerErrorResponseBody(res *goa.ServiceError) *ListUsersInternalServerErrorResponseBody {
+	body := &ListUsersInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsersServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "list-users" endpoint of the "access-svc" service.
+func NewListUsersServiceUnavailableResponseBody(res *goa.ServiceError) *ListUsersServiceUnavailableResponseBody {
+	body := &ListUsersServiceUnavailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsersGatewayTimeoutResponseBody builds the HTTP response body from
+// the result of the "list-users" endpoint of the "access-svc" service.
+func NewListUsersGatewayTimeoutResponseBody(res *goa.ServiceError) *ListUsersGatewayTimeoutResponseBody {
+	body := &ListUsersGatewayTimeoutResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsersTooManyRequestsResponseBody builds the HTTP response body from
+// the result of the "list-users" endpoint of the "access-svc" service.
+func NewListUsersTooManyRequestsResponseBody(res *goa.ServiceError) *ListUsersTooManyRequestsResponseBody {
+	body := &ListUsersTooManyRequestsResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMyPermissionsBadRequestResponseBody builds the HTTP response body from
+// the result of the "my-permissions" endpoint of the "access-svc" service.
+func NewMyPermissionsBadRequestResponseBody(res *goa.ServiceError) *MyPermissionsBadRequestResponseBody {
+	body := &MyPermissionsBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMyPermissionsUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "my-permissions" endpoint of the "access-svc" service.
+func NewMyPermissionsUnauthorizedResponseBody(res *goa.ServiceError) *MyPermissionsUnauthorizedResponseBody {
+	body := &MyPermissionsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMyPermissionsInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "my-permissions" endpoint of the "access-svc"
+// service.
+func NewMyPermissionsInternalServerErrorResponseBody(res *goa.ServiceError) *MyPermissionsInternalServerErrorResponseBody {
+	body := &MyPermissionsInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMyPermissionsServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "my-permissions" endpoint of the "access-svc" service.
+func NewMyPermissionsServiceUnavailableResponseBody(res *goa.ServiceError) *MyPermissionsServiceUnavailableResponseBody {
+	body := &MyPermissionsServiceUnavailableResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMyPermissionsGatewayTimeoutResponseBody builds the HTTP response body
+// from the result of the "my-permissions" endpoint of the "access-svc" service.
+func NewMyPermissionsGatewayTimeoutResponseBody(res *goa.ServiceError) *MyPermissionsGatewayTimeoutResponseBody {
+	body := &MyPermissionsGatewayTimeoutResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMyPermissionsTooManyRequestsResponseBody builds the HTTP response body
+// from the result of the "my-permissions" endpoint of the "access-svc" service.
+func NewMyPermissionsTooManyRequestsResponseBody(res *goa.ServiceError) *MyPermissionsTooManyRequestsResponseBody {
+	body := &MyPermissionsTooManyRequestsResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewReadyzNotReadyResponseBody builds the HTTP response body from the result
 // of the "readyz" endpoint of the "access-svc" service.
 func NewReadyzNotReadyResponseBody(res *goa.ServiceError) *ReadyzNotReadyResponseBody {
@@ -364,6 +1307,24 @@ func NewCheckAccessPayload(body *CheckAccessRequestBody, version string, bearerT
 	for i, val := range body.Requests {
 		v.Requests[i] = val
 	}
+	if body.ContextualTuples != nil {
+		v.ContextualTuples = make([]*accesssvc.ContextualTuple, len(body.ContextualTuples))
+		for i, val := range body.ContextualTuples {
+			if val == nil {
+				v.ContextualTuples[i] = nil
+				continue
+			}
+			v.ContextualTuples[i] = unmarshalContextualTupleRequestBodyToAccesssvcContextualTuple(val)
+		}
+	}
+	if body.Context != nil {
+		v.Context = make(map[string]any, len(body.Context))
+		for key, val := range body.Context {
+			tk := key
+			tv := val
+			v.Context[tk] = tv
+		}
+	}
 	v.Version = version
 	v.BearerToken = bearerToken
 
@@ -371,10 +1332,47 @@ func NewCheckAccessPayload(body *CheckAccessRequestBody, version string, bearerT
 }
 
 // NewMyGrantsPayload builds a access-svc service my-grants endpoint payload.
-func NewMyGrantsPayload(version string, objectType string, bearerToken string) *accesssvc.MyGrantsPayload {
+func NewMyGrantsPayload(version string, objectType []string, relation *string, pageSize int, continuationToken *string, bearerToken string) *accesssvc.MyGrantsPayload {
 	v := &accesssvc.MyGrantsPayload{}
 	v.Version = version
 	v.ObjectType = objectType
+	v.Relation = relation
+	v.PageSize = pageSize
+	v.ContinuationToken = continuationToken
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewListObjectsPayload builds a access-svc service list-objects endpoint
+// payload.
+func NewListObjectsPayload(version string, objectType string, relation string, bearerToken string) *accesssvc.ListObjectsPayload {
+	v := &accesssvc.ListObjectsPayload{}
+	v.Version = version
+	v.ObjectType = objectType
+	v.Relation = relation
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewListUsersPayload builds a access-svc service list-users endpoint payload.
+func NewListUsersPayload(version string, object string, relation string, bearerToken string) *accesssvc.ListUsersPayload {
+	v := &accesssvc.ListUsersPayload{}
+	v.Version = version
+	v.Object = object
+	v.Relation = relation
+	v.BearerToken = bearerToken
+
+	return v
+}
+
+// NewMyPermissionsPayload builds a access-svc service my-permissions endpoint
+// payload.
+func NewMyPermissionsPayload(version string, object string, bearerToken string) *accesssvc.MyPermissionsPayload {
+	v := &accesssvc.MyPermissionsPayload{}
+	v.Version = version
+	v.Object = object
 	v.BearerToken = bearerToken
 
 	return v
@@ -389,5 +1387,49 @@ func ValidateCheckAccessRequestBody(body *CheckAccessRequestBody) (err error) {
 	if len(body.Requests) < 1 {
 		err = goa.MergeErrors(err, goa.InvalidLengthError("body.requests", body.Requests, len(body.Requests), 1, true))
 	}
+	if len(body.Requests) > 1000 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.requests", body.Requests, len(body.Requests), 1000, false))
+	}
+	if len(body.ContextualTuples) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.contextual_tuples", body.ContextualTuples, len(body.ContextualTuples), 100, false))
+	}
+	for _, e := range body.ContextualTuples {
+		if e != nil {
+			if err2 := ValidateContextualTupleRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateContextualTupleRequestBody runs the validations defined on
+// ContextualTupleRequestBody
+func ValidateContextualTupleRequestBody(body *ContextualTupleRequestBody) (err error) {
+	if body.Object == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("object", "body"))
+	}
+	if body.Relation == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("relation", "body"))
+	}
+	if body.User == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user", "body"))
+	}
+	if body.Object != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.object", *body.Object, "^[a-z][a-z0-9_]*:[A-Za-z0-9][A-Za-z0-9._~|+=-]*$"))
+	}
+	if body.Object != nil {
+		if utf8.RuneCountInString(*body.Object) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.object", *body.Object, utf8.RuneCountInString(*body.Object), 256, false))
+		}
+	}
+	if body.Relation != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.relation", *body.Relation, "^[a-z][a-z0-9_]*$"))
+	}
+	if body.Relation != nil {
+		if utf8.RuneCountInString(*body.Relation) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.relation", *body.Relation, utf8.RuneCountInString(*body.Relation), 50, false))
+		}
+	}
 	return
 }
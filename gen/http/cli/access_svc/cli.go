@@ -23,13 +23,13 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"access-svc (check-access|my-grants|readyz|livez)",
+		"access-svc (check-access|my-grants|list-objects|list-users|my-permissions|readyz|livez)",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + " " + "access-svc check-access --body '{\n      \"requests\": [\n         \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#auditor\",\n         \"committee:b3c72e18-1a2b-4c3d-8e9f-123456789abc#writer\"\n      ]\n   }' --version \"1\" --bearer-token \"Sed occaecati officia fugit.\"" + "\n" +
+	return os.Args[0] + " " + "access-svc check-access --body '{\n      \"context\": {\n         \"current_time\": \"2026-01-01T00:00:00Z\"\n      },\n      \"contextual_tuples\": [\n         {\n            \"object\": \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f\",\n            \"relation\": \"viewer\",\n            \"user\": \"user:auth0|bob\"\n         },\n         {\n            \"object\": \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f\",\n            \"relation\": \"viewer\",\n            \"user\": \"user:auth0|bob\"\n         },\n         {\n            \"object\": \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f\",\n            \"relation\": \"viewer\",\n            \"user\": \"user:auth0|bob\"\n         }\n      ],\n      \"requests\": [\n         \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#auditor\",\n         \"committee:b3c72e18-1a2b-4c3d-8e9f-123456789abc#writer\"\n      ]\n   }' --version \"1\" --bearer-token \"Eius suscipit itaque velit dolorem.\"" + "\n" +
 		""
 }
 
@@ -50,10 +50,30 @@ func ParseEndpoint(
 		accessSvcCheckAccessVersionFlag     = accessSvcCheckAccessFlags.String("version", "REQUIRED", "")
 		accessSvcCheckAccessBearerTokenFlag = accessSvcCheckAccessFlags.String("bearer-token", "REQUIRED", "")
 
-		accessSvcMyGrantsFlags           = flag.NewFlagSet("my-grants", flag.ExitOnError)
-		accessSvcMyGrantsVersionFlag     = accessSvcMyGrantsFlags.String("version", "REQUIRED", "")
-		accessSvcMyGrantsObjectTypeFlag  = accessSvcMyGrantsFlags.String("object-type", "REQUIRED", "")
-		accessSvcMyGrantsBearerTokenFlag = accessSvcMyGrantsFlags.String("bearer-token", "REQUIRED", "")
+		accessSvcMyGrantsFlags                 = flag.NewFlagSet("my-grants", flag.ExitOnError)
+		accessSvcMyGrantsVersionFlag           = accessSvcMyGrantsFlags.String("version", "REQUIRED", "")
+		accessSvcMyGrantsObjectTypeFlag        = accessSvcMyGrantsFlags.String("object-type", "REQUIRED", "")
+		accessSvcMyGrantsRelationFlag          = accessSvcMyGrantsFlags.String("relation", "", "")
+		accessSvcMyGrantsPageSizeFlag          = accessSvcMyGrantsFlags.String("page-size", "100", "")
+		accessSvcMyGrantsContinuationTokenFlag = accessSvcMyGrantsFlags.String("continuation-token", "", "")
+		accessSvcMyGrantsBearerTokenFlag       = accessSvcMyGrantsFlags.String("bearer-token", "REQUIRED", "")
+
+		accessSvcListObjectsFlags           = flag.NewFlagSet("list-objects", flag.ExitOnError)
+		accessSvcListObjectsVersionFlag     = accessSvcListObjectsFlags.String("version", "REQUIRED", "")
+		accessSvcListObjectsObjectTypeFlag  = accessSvcListObjectsFlags.String("object-type", "REQUIRED", "")
+		accessSvcListObjectsRelationFlag    = accessSvcListObjectsFlags.String("relation", "REQUIRED", "")
+		accessSvcListObjectsBearerTokenFlag = accessSvcListObjectsFlags.String("bearer-token", "REQUIRED", "")
+
+		accessSvcListUsersFlags           = flag.NewFlagSet("list-users", flag.ExitOnError)
+		accessSvcListUsersVersionFlag     = accessSvcListUsersFlags.String("version", "REQUIRED", "")
+		accessSvcListUsersObjectFlag      = accessSvcListUsersFlags.String("object", "REQUIRED", "")
+		accessSvcListUsersRelationFlag    = accessSvcListUsersFlags.String("relation", "REQUIRED", "")
+		accessSvcListUsersBearerTokenFlag = accessSvcListUsersFlags.String("bearer-token", "REQUIRED", "")
+
+		accessSvcMyPermissionsFlags           = flag.NewFlagSet("my-permissions", flag.ExitOnError)
+		accessSvcMyPermissionsVersionFlag     = accessSvcMyPermissionsFlags.String("version", "REQUIRED", "")
+		accessSvcMyPermissionsObjectFlag      = accessSvcMyPermissionsFlags.String("object", "REQUIRED", "")
+		accessSvcMyPermissionsBearerTokenFlag = accessSvcMyPermissionsFlags.String("bearer-token", "REQUIRED", "")
 
 		accessSvcReadyzFlags = flag.NewFlagSet("readyz", flag.ExitOnError)
 
@@ -62,6 +82,9 @@ func ParseEndpoint(
 	accessSvcFlags.Usage = accessSvcUsage
 	accessSvcCheckAccessFlags.Usage = accessSvcCheckAccessUsage
 	accessSvcMyGrantsFlags.Usage = accessSvcMyGrantsUsage
+	accessSvcListObjectsFlags.Usage = accessSvcListObjectsUsage
+	accessSvcListUsersFlags.Usage = accessSvcListUsersUsage
+	accessSvcMyPermissionsFlags.Usage = accessSvcMyPermissionsUsage
 	accessSvcReadyzFlags.Usage = accessSvcReadyzUsage
 	accessSvcLivezFlags.Usage = accessSvcLivezUsage
 
@@ -105,6 +128,15 @@ func ParseEndpoint(
 			case "my-grants":
 				epf = accessSvcMyGrantsFlags
 
+			case "list-objects":
+				epf = accessSvcListObjectsFlags
+
+			case "list-users":
+				epf = accessSvcListUsersFlags
+
+			case "my-permissions":
+				epf = accessSvcMyPermissionsFlags
+
 			case "readyz":
 				epf = accessSvcReadyzFlags
 
@@ -141,7 +173,16 @@ func ParseEndpoint(
 				data, err = accesssvcc.BuildCheckAccessPayload(*accessSvcCheckAccessBodyFlag, *accessSvcCheckAccessVersionFlag, *accessSvcCheckAccessBearerTokenFlag)
 			case "my-grants":
 				endpoint = c.MyGrants()
-				data, err = accesssvcc.BuildMyGrantsPayload(*accessSvcMyGrantsVersionFlag, *accessSvcMyGrantsObjectTypeFlag, *accessSvcMyGrantsBearerTokenFlag)
+				data, err = accesssvcc.BuildMyGrantsPayload(*accessSvcMyGrantsVersionFlag, *accessSvcMyGrantsObjectTypeFlag, *accessSvcMyGrantsRelationFlag, *accessSvcMyGrantsPageSizeFlag, *accessSvcMyGrantsContinuationTokenFlag, *accessSvcMyGrantsBearerTokenFlag)
+			case "list-objects":
+				endpoint = c.ListObjects()
+				data, err = accesssvcc.BuildListObjectsPayload(*accessSvcListObjectsVersionFlag, *accessSvcListObjectsObjectTypeFlag, *accessSvcListObjectsRelationFlag, *accessSvcListObjectsBearerTokenFlag)
+			case "list-users":
+				endpoint = c.ListUsers()
+				data, err = accesssvcc.BuildListUsersPayload(*accessSvcListUsersVersionFlag, *accessSvcListUsersObjectFlag, *accessSvcListUsersRelationFlag, *accessSvcListUsersBearerTokenFlag)
+			case "my-permissions":
+				endpoint = c.MyPermissions()
+				data, err = accesssvcc.BuildMyPermissionsPayload(*accessSvcMyPermissionsVersionFlag, *accessSvcMyPermissionsObjectFlag, *accessSvcMyPermissionsBearerTokenFlag)
 			case "readyz":
 				endpoint = c.Readyz()
 			case "livez":
@@ -163,7 +204,10 @@ func accessSvcUsage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] access-svc COMMAND [flags]\n\n", os.Args[0])
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    check-access: Check access permissions for resource-action pairs`)
-	fmt.Fprintln(os.Stderr, `    my-grants: Get the caller's direct access grants for a given object type`)
+	fmt.Fprintln(os.Stderr, `    my-grants: Get the caller's direct access grants for one or more object types, optionally filtered by relation`)
+	fmt.Fprintln(os.Stderr, `    list-objects: List every object of a type the caller holds a relation on, including access inherited through parent objects`)
+	fmt.Fprintln(os.Stderr, `    list-users: List the users and usersets holding a relation on an object. The caller must itself hold the configured guard relation on the object`)
+	fmt.Fprintln(os.Stderr, `    my-permissions: List every relation the caller effectively holds on one object, as defined by the configured permission model`)
 	fmt.Fprintln(os.Stderr, `    readyz: Check if service is ready`)
 	fmt.Fprintln(os.Stderr, `    livez: Check if service is alive`)
 	fmt.Fprintln(os.Stderr)
@@ -189,29 +233,105 @@ func accessSvcCheckAccessUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "access-svc check-access --body '{\n      \"requests\": [\n         \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#auditor\",\n         \"committee:b3c72e18-1a2b-4c3d-8e9f-123456789abc#writer\"\n      ]\n   }' --version \"1\" --bearer-token \"Sed occaecati officia fugit.\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "access-svc check-access --body '{\n      \"context\": {\n         \"current_time\": \"2026-01-01T00:00:00Z\"\n      },\n      \"contextual_tuples\": [\n         {\n            \"object\": \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f\",\n            \"relation\": \"viewer\",\n            \"user\": \"user:auth0|bob\"\n         },\n         {\n            \"object\": \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f\",\n            \"relation\": \"viewer\",\n            \"user\": \"user:auth0|bob\"\n         },\n         {\n            \"object\": \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f\",\n            \"relation\": \"viewer\",\n            \"user\": \"user:auth0|bob\"\n         }\n      ],\n      \"requests\": [\n         \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#auditor\",\n         \"committee:b3c72e18-1a2b-4c3d-8e9f-123456789abc#writer\"\n      ]\n   }' --version \"1\" --bearer-token \"Eius suscipit itaque velit dolorem.\"")
 }
 
 func accessSvcMyGrantsUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] access-svc my-grants", os.Args[0])
 	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -object-type JSON")
+	fmt.Fprint(os.Stderr, " -relation STRING")
+	fmt.Fprint(os.Stderr, " -page-size INT")
+	fmt.Fprint(os.Stderr, " -continuation-token STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the caller's direct access grants for one or more object types, optionally filtered by relation`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -object-type JSON: `)
+	fmt.Fprintln(os.Stderr, `    -relation STRING: `)
+	fmt.Fprintln(os.Stderr, `    -page-size INT: `)
+	fmt.Fprintln(os.Stderr, `    -continuation-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "access-svc my-grants --version \"1\" --object-type '[\n      \"project\",\n      \"committee\"\n   ]' --relation \"writer\" --page-size 100 --continuation-token \"usz\" --bearer-token \"Aut aut rerum dolores voluptas.\"")
+}
+
+func accessSvcListObjectsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] access-svc list-objects", os.Args[0])
+	fmt.Fprint(os.Stderr, " -version STRING")
 	fmt.Fprint(os.Stderr, " -object-type STRING")
+	fmt.Fprint(os.Stderr, " -relation STRING")
 	fmt.Fprint(os.Stderr, " -bearer-token STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Get the caller's direct access grants for a given object type`)
+	fmt.Fprintln(os.Stderr, `List every object of a type the caller holds a relation on, including access inherited through parent objects`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -version STRING: `)
 	fmt.Fprintln(os.Stderr, `    -object-type STRING: `)
+	fmt.Fprintln(os.Stderr, `    -relation STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "access-svc list-objects --version \"1\" --object-type \"project\" --relation \"viewer\" --bearer-token \"Optio cum porro et eligendi dolorem.\"")
+}
+
+func accessSvcListUsersUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] access-svc list-users", os.Args[0])
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -object STRING")
+	fmt.Fprint(os.Stderr, " -relation STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the users and usersets holding a relation on an object. The caller must itself hold the configured guard relation on the object`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -object STRING: `)
+	fmt.Fprintln(os.Stderr, `    -relation STRING: `)
+	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "access-svc list-users --version \"1\" --object \"committee:b3c72e18-1a2b-4c3d-8e9f-123456789abc\" --relation \"writer\" --bearer-token \"Non quos.\"")
+}
+
+func accessSvcMyPermissionsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] access-svc my-permissions", os.Args[0])
+	fmt.Fprint(os.Stderr, " -version STRING")
+	fmt.Fprint(os.Stderr, " -object STRING")
+	fmt.Fprint(os.Stderr, " -bearer-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List every relation the caller effectively holds on one object, as defined by the configured permission model`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -version STRING: `)
+	fmt.Fprintln(os.Stderr, `    -object STRING: `)
 	fmt.Fprintln(os.Stderr, `    -bearer-token STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "access-svc my-grants --version \"1\" --object-type \"project\" --bearer-token \"Et quibusdam atque laudantium inventore quod atque.\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "access-svc my-permissions --version \"1\" --object \"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f\" --bearer-token \"Dolore voluptatibus eveniet ut inventore quae magnam.\"")
 }
 
 func accessSvcReadyzUsage() {
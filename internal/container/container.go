@@ -5,14 +5,20 @@
 package container
 
 import (
+	"context"
+	"fmt"
 	"log/slog"
+	"os"
 
 	accesssvc "github.com/linuxfoundation/lfx-v2-access-check/gen/access_svc"
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
 	"github.com/linuxfoundation/lfx-v2-access-check/internal/infrastructure/auth"
 	"github.com/linuxfoundation/lfx-v2-access-check/internal/infrastructure/config"
 	"github.com/linuxfoundation/lfx-v2-access-check/internal/infrastructure/messaging"
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/infrastructure/ratelimit"
 	"github.com/linuxfoundation/lfx-v2-access-check/internal/service"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
 )
 
 // Container holds all application dependencies
@@ -24,15 +30,64 @@ type Container struct {
 	AccessService accesssvc.Service
 
 	// Private fields for cleanup (not exposed to consumers)
-	messagingRepo contracts.MessagingRepository
+	messagingRepo  contracts.MessagingRepository
+	rateLimitStore contracts.RateLimitStore
 }
 
 // NewContainer creates a new dependency injection container
 func NewContainer(cfg *config.Config) (*Container, error) {
 	slog.Info("Initializing dependency container")
 
+	if cfg.ListUsersRelation != "" {
+		if err := domain.ValidateRelation(cfg.ListUsersRelation); err != nil {
+			slog.Error("Invalid list-users guard relation", "error", err)
+			return nil, fmt.Errorf("%s: %w", constants.EnvListUsersRelation, err)
+		}
+	}
+
+	// Degraded mode serves decisions kept by the decision cache; without the
+	// cache it would silently never engage.
+	if cfg.DegradedMaxStaleness > 0 && cfg.DecisionCacheSize == 0 {
+		slog.Error("Degraded mode needs the decision cache",
+			"max_staleness", cfg.DegradedMaxStaleness, "decision_cache_size", cfg.DecisionCacheSize)
+		return nil, fmt.Errorf("%s: %w", constants.EnvDegradedMaxStaleness, constants.ErrDegradedNeedsCache)
+	}
+
+	permissionModel := domain.DefaultPermissionModel()
+	if cfg.PermissionModelPath != "" {
+		data, err := os.ReadFile(cfg.PermissionModelPath)
+		if err != nil {
+			slog.Error("Failed to read permission model", "error", err, "path", cfg.PermissionModelPath)
+			return nil, fmt.Errorf("%s: %w", constants.EnvPermissionModelPath, err)
+		}
+		permissionModel, err = domain.ParsePermissionModel(data)
+		if err != nil {
+			slog.Error("Invalid permission model", "error", err, "path", cfg.PermissionModelPath)
+			return nil, fmt.Errorf("%s: %w", constants.EnvPermissionModelPath, err)
+		}
+	}
+
+	rateLimitBurst := max(cfg.RateLimitBurst, 1)
+	var rateLimitOverrides map[string]domain.RateLimit
+	if cfg.RateLimitOverrides != "" {
+		var err error
+		rateLimitOverrides, err = domain.ParseRateLimitOverrides(cfg.RateLimitOverrides, rateLimitBurst)
+		if err != nil {
+			slog.Error("Invalid rate limit overrides", "error", err)
+			return nil, fmt.Errorf("%s: %w", constants.EnvRateLimitOverrides, err)
+		}
+	}
+
 	// Initialize repositories
-	authRepo, err := auth.NewAuthRepository(cfg.JWKSUrl, cfg.Issuer, cfg.Audience)
+	var (
+		authRepo contracts.AuthRepository
+		err      error
+	)
+	if cfg.TrustedIssuersPath != "" {
+		authRepo, err = newTrustedIssuersAuthRepository(cfg.TrustedIssuersPath)
+	} else {
+		authRepo, err = auth.NewAuthRepository(cfg.JWKSUrl, cfg.Issuer, cfg.Audience, cfg.JWTAlgorithms...)
+	}
 	if err != nil {
 		slog.Error("Failed to initialize auth repository", "error", err)
 		return nil, err
@@ -43,20 +98,127 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 		slog.Error("Failed to initialize messaging repository", "error", err)
 		return nil, err
 	}
+	messagingRepo = newMessagingChain(messagingRepo, cfg)
+
+	// Rate limiting is on when there is a default limit or any override.
+	var rateLimiter *service.RateLimiter
+	var rateLimitStore contracts.RateLimitStore
+	if cfg.RateLimitRate > 0 || len(rateLimitOverrides) > 0 {
+		rateLimitStore, err = newRateLimitStore(cfg)
+		if err != nil {
+			slog.Error("Failed to initialize rate limit store", "error", err, "store", cfg.RateLimitStore)
+			_ = messagingRepo.Close()
+			return nil, err
+		}
+		limit := domain.RateLimit{Rate: cfg.RateLimitRate, Burst: rateLimitBurst}
+		rateLimiter = service.NewRateLimiter(rateLimitStore, limit, rateLimitOverrides, cfg.RateLimitKey)
+	}
 
 	// Initialize services - Create unified access service
-	accessService := service.NewAccessService(authRepo, messagingRepo)
+	accessService := service.NewAccessService(authRepo, messagingRepo,
+		service.WithListUsersRelation(cfg.ListUsersRelation),
+		service.WithPermissionModel(permissionModel),
+		service.WithContextualTupleClients(cfg.ContextualTupleClients),
+		service.WithDecisionCache(cfg.DecisionCacheSize, cfg.DecisionCacheTTL),
+		service.WithDegradedMode(cfg.DegradedMaxStaleness, cfg.DegradedFailClosed),
+		service.WithGrantsCache(cfg.GrantsCacheSize, cfg.GrantsCacheTTL),
+		service.WithTokenCache(cfg.TokenCacheSize, cfg.TokenCacheTTL),
+		service.WithChunking(cfg.CheckChunkSize, cfg.CheckChunkParallelism),
+		service.WithBatching(cfg.CheckBatchWindow, cfg.CheckBatchMaxSize, cfg.RequestTimeoutMax),
+		service.WithRateLimiter(rateLimiter),
+	)
+
+	// Without a cache to evict from there is nothing to invalidate.
+	cachesEnabled := cfg.DecisionCacheSize > 0 || cfg.GrantsCacheSize > 0
+	if cachesEnabled && cfg.TupleChangeSubject != "" {
+		if err := accessService.SubscribeTupleChanges(cfg.TupleChangeSubject); err != nil {
+			slog.Error("Failed to subscribe to tuple changes", "error", err, "subject", cfg.TupleChangeSubject)
+			_ = messagingRepo.Close()
+			if rateLimitStore != nil {
+				_ = rateLimitStore.Close()
+			}
+			return nil, err
+		}
+	}
 
 	slog.Info("Dependency container initialized successfully")
 	return &Container{
-		Config:        cfg,
-		AccessService: accessService,
-		messagingRepo: messagingRepo,
+		Config:         cfg,
+		AccessService:  accessService,
+		messagingRepo:  messagingRepo,
+		rateLimitStore: rateLimitStore,
 	}, nil
 }
 
+// newMessagingChain wraps next in the configured resilience decorators. From
+// the outside in: coalescing, circuit breaker, retries, hedging. Coalescing
+// carries the caller's deadline into the shared request, so retries below it
+// budget their attempts by that deadline.
+func newMessagingChain(next contracts.MessagingRepository, cfg *config.Config) contracts.MessagingRepository {
+	// Every subject the service sends to is a read; none changes state, so
+	// all of them may be hedged and retried.
+	readSubjects := []string{
+		constants.AccessCheckSubject,
+		constants.ReadTuplesSubject,
+		constants.ListObjectsSubject,
+		constants.ListUsersSubject,
+	}
+	if cfg.NATSHedgePercentile > 0 {
+		next = messaging.NewHedgingRepository(next, messaging.HedgePolicy{
+			Percentile:    cfg.NATSHedgePercentile,
+			MinDelay:      cfg.NATSHedgeMinDelay,
+			BudgetPercent: cfg.NATSHedgeBudgetPercent,
+			Subjects:      readSubjects,
+		})
+	}
+	if cfg.NATSRetryMaxAttempts > 1 {
+		next = messaging.NewRetryingRepository(next, messaging.RetryPolicy{
+			MaxAttempts:       cfg.NATSRetryMaxAttempts,
+			InitialBackoff:    cfg.NATSRetryInitialBackoff,
+			MaxBackoff:        cfg.NATSRetryMaxBackoff,
+			MinAttemptTimeout: cfg.NATSRetryMinAttemptTimeout,
+			Subjects:          readSubjects,
+		})
+	}
+	if cfg.CircuitBreakerFailureThreshold > 0 {
+		next = messaging.NewCircuitBreakerRepository(next,
+			cfg.CircuitBreakerFailureThreshold, cfg.CircuitBreakerOpenTimeout, cfg.CircuitBreakerMinTimeout)
+	}
+	return messaging.NewCoalescingRepository(next)
+}
+
+// newRateLimitStore creates the configured rate-limit store.
+func newRateLimitStore(cfg *config.Config) (contracts.RateLimitStore, error) {
+	if cfg.RateLimitStore != constants.RateLimitStoreNATSKV {
+		return ratelimit.NewMemoryStore(constants.DefaultRateLimitMaxKeys, constants.DefaultRateLimitStateTTL), nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultNATSTimeout)
+	defer cancel()
+	return ratelimit.NewNATSKVStore(ctx, cfg.NATSUrl, cfg.RateLimitKVBucket, constants.DefaultRateLimitStateTTL)
+}
+
+// newTrustedIssuersAuthRepository creates an auth repository trusting the
+// issuers listed in the file at path.
+func newTrustedIssuersAuthRepository(path string) (contracts.AuthRepository, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", constants.EnvTrustedIssuersPath, err)
+	}
+	issuers, err := auth.ParseTrustedIssuers(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", constants.EnvTrustedIssuersPath, err)
+	}
+	return auth.NewTrustedIssuersAuthRepository(issuers)
+}
+
 // Close cleans up resources
 func (c *Container) Close() error {
+	if c.rateLimitStore != nil {
+		if err := c.rateLimitStore.Close(); err != nil {
+			slog.Error("Failed to close rate limit store", "error", err)
+		}
+	}
 	if c.messagingRepo != nil {
 		err := c.messagingRepo.Close()
 		if err != nil {
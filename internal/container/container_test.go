@@ -4,9 +4,17 @@
 package container
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
 	"github.com/linuxfoundation/lfx-v2-access-check/internal/infrastructure/config"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+	"github.com/nats-io/nats.go"
 )
 
 func TestNewContainer_WithMocks(t *testing.T) {
@@ -321,6 +329,63 @@ func TestNewContainer_ErrorPropagation(t *testing.T) {
 			wantErr: true,
 			errType: "messaging repository",
 		},
+		{
+			name: "invalid_list_users_relation",
+			config: &config.Config{
+				JWKSUrl:           "https://example.com/.well-known/jwks",
+				Issuer:            "https://example.com",
+				Audience:          "test",
+				NATSUrl:           "nats://localhost:4222",
+				ListUsersRelation: "Writer#member",
+			},
+			wantErr: true,
+			errType: "list-users relation",
+		},
+		{
+			name: "missing_permission_model",
+			config: &config.Config{
+				JWKSUrl:             "https://example.com/.well-known/jwks",
+				Issuer:              "https://example.com",
+				Audience:            "test",
+				NATSUrl:             "nats://localhost:4222",
+				PermissionModelPath: "/nonexistent/permission-model.json",
+			},
+			wantErr: true,
+			errType: "permission model",
+		},
+		{
+			name: "invalid_rate_limit_overrides",
+			config: &config.Config{
+				JWKSUrl:            "https://example.com/.well-known/jwks",
+				Issuer:             "https://example.com",
+				Audience:           "test",
+				NATSUrl:            "nats://localhost:4222",
+				RateLimitOverrides: "alice=fast",
+			},
+			wantErr: true,
+			errType: "rate limit overrides",
+		},
+		{
+			name: "hmac_jwt_algorithm",
+			config: &config.Config{
+				JWKSUrl:       "https://example.com/.well-known/jwks",
+				Issuer:        "https://example.com",
+				Audience:      "test",
+				JWTAlgorithms: []string{"HS256"},
+				NATSUrl:       "nats://localhost:4222",
+			},
+			wantErr: true,
+			errType: "JWT algorithm",
+		},
+		{
+			name: "missing_trusted_issuers_file",
+			config: &config.Config{
+				TrustedIssuersPath: "/nonexistent/trusted-issuers.json",
+				NATSUrl:            "nats://localhost:4222",
+			},
+			wantErr: true,
+			errType: "trusted issuers",
+		},
 	}
 
 	for _, tt := range tests {
@@ -421,3 +486,103 @@ func TestNewContainer_EdgeCases(t *testing.T) {
 		container.Close()
 	}
 }
+
+// timeoutRepository is a messaging repository whose requests all time out. It
+// records the timeout and context deadline of every request it receives.
+type timeoutRepository struct {
+	mu        sync.Mutex
+	timeouts  []time.Duration
+	deadlines []time.Duration
+}
+
+func (r *timeoutRepository) Request(ctx context.Context, _ string, _ []byte, timeout time.Duration) ([]byte, error) {
+	deadline, ok := ctx.Deadline()
+	r.mu.Lock()
+	r.timeouts = append(r.timeouts, timeout)
+	if ok {
+		r.deadlines = append(r.deadlines, time.Until(deadline))
+	}
+	r.mu.Unlock()
+
+	select {
+	case <-time.After(timeout):
+		return nil, fmt.Errorf("%s: %w", constants.ErrMsgNATSRequestFailed, nats.ErrTimeout)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (r *timeoutRepository) Subscribe(string, contracts.MessageHandler) error { return nil }
+func (r *timeoutRepository) Close() error                                     { return nil }
+func (r *timeoutRepository) HealthCheck(context.Context) error                { return nil }
+
+func TestNewMessagingChain_AttemptDeadlines(t *testing.T) {
+	tests := []struct {
+		name              string
+		minAttemptTimeout time.Duration
+		wantAttempts      int
+	}{
+		// The caller's deadline reaches the retries below coalescing, which
+		// split it among the attempts.
+		{"deadline split among attempts", 0, 3},
+		// With the default minimum the first attempt keeps the whole budget.
+		{"default minimum keeps the budget", constants.DefaultNATSRetryMinAttemptTimeout, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := &timeoutRepository{}
+			chain := newMessagingChain(backend, &config.Config{
+				NATSRetryMaxAttempts:           3,
+				NATSRetryInitialBackoff:        time.Millisecond,
+				NATSRetryMaxBackoff:            time.Millisecond,
+				NATSRetryMinAttemptTimeout:     tt.minAttemptTimeout,
+				CircuitBreakerFailureThreshold: constants.DefaultCircuitBreakerFailureThreshold,
+				CircuitBreakerOpenTimeout:      constants.DefaultCircuitBreakerOpenTimeout,
+			})
+
+			const budget = 300 * time.Millisecond
+			ctx, cancel := context.WithTimeout(context.Background(), budget)
+			defer cancel()
+			if _, err := chain.Request(ctx, constants.AccessCheckSubject, []byte("req"), budget); err == nil {
+				t.Fatal("Request() succeeded; want the timeout")
+			}
+
+			backend.mu.Lock()
+			defer backend.mu.Unlock()
+			if len(backend.timeouts) != tt.wantAttempts {
+				t.Fatalf("backend saw %d attempts (timeouts %v); want %d", len(backend.timeouts), backend.timeouts, tt.wantAttempts)
+			}
+			if len(backend.deadlines) != tt.wantAttempts {
+				t.Fatalf("backend saw a deadline on %d of %d attempts; want all", len(backend.deadlines), tt.wantAttempts)
+			}
+			share := budget / time.Duration(tt.wantAttempts)
+			for i, timeout := range backend.timeouts {
+				if timeout > share || timeout < share/2 {
+					t.Errorf("attempt %d timeout = %v; want about %v", i+1, timeout, share)
+				}
+				if backend.deadlines[i] > budget {
+					t.Errorf("attempt %d deadline in %v; want within the caller's %v", i+1, backend.deadlines[i], budget)
+				}
+			}
+		})
+	}
+}
+
+func TestNewContainer_DegradedModeNeedsDecisionCache(t *testing.T) {
+	cfg := &config.Config{
+		JWKSUrl:              "https://example.com/.well-known/jwks",
+		Issuer:               "https://example.com",
+		Audience:             "test",
+		NATSUrl:              "nats://localhost:4222",
+		DegradedMaxStaleness: 5 * time.Minute,
+	}
+
+	container, err := NewContainer(cfg)
+	if !errors.Is(err, constants.ErrDegradedNeedsCache) {
+		t.Errorf("NewContainer() error = %v; want ErrDegradedNeedsCache", err)
+	}
+	if container != nil {
+		t.Error("Expected nil container when degraded mode has no decision cache")
+	}
+}
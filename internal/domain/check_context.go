@@ -0,0 +1,58 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+// CheckContext holds request-scoped inputs that apply to every check of one
+// access-check call: contextual tuples OpenFGA treats as if they were stored,
+// and the condition context evaluated by conditional relations (time windows,
+// IP ranges).
+type CheckContext struct {
+	ContextualTuples []Tuple
+	Context          map[string]any
+}
+
+// IsZero reports whether the check context carries neither contextual tuples
+// nor condition context, in which case checks can use the plaintext protocol.
+func (c CheckContext) IsZero() bool {
+	return len(c.ContextualTuples) == 0 && len(c.Context) == 0
+}
+
+// Validate checks the number of contextual tuples and that each one is a
+// well-formed tuple that cannot grant a checked user anything: a contextual
+// tuple must relate a single principal other than the user of any check. A
+// wildcard, a userset or an object (such as a parent link) could expand to a
+// checked user through the model, so the caller could grant itself any
+// relation; those are refused too. The error names the index and reason of
+// every invalid tuple.
+func (c CheckContext) Validate(checks []Tuple) error {
+	if len(c.ContextualTuples) > constants.MaxContextualTuples {
+		return fmt.Errorf("contextual_tuples: at most %d tuples allowed, got %d", constants.MaxContextualTuples, len(c.ContextualTuples))
+	}
+	var problems []string
+	for i, t := range c.ContextualTuples {
+		switch err := t.Validate(); {
+		case err != nil:
+			problems = append(problems, fmt.Sprintf("contextual_tuples[%d]: %v", i, err))
+		case slices.Contains(checks, t):
+			problems = append(problems, fmt.Sprintf("contextual_tuples[%d]: must not state a checked tuple", i))
+		case slices.ContainsFunc(checks, func(check Tuple) bool { return check.User == t.User }):
+			problems = append(problems, fmt.Sprintf("contextual_tuples[%d]: must not grant a relation to the checked user", i))
+		case !IsPrincipal(t.User):
+			problems = append(problems, fmt.Sprintf("contextual_tuples[%d]: user must be a single principal, not a wildcard, userset or object", i))
+		}
+	}
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+func TestCheckContext_IsZero(t *testing.T) {
+	if !(CheckContext{}).IsZero() {
+		t.Error("expected empty check context to be zero")
+	}
+	if (CheckContext{Context: map[string]any{"ip": "10.0.0.1"}}).IsZero() {
+		t.Error("expected check context with condition context not to be zero")
+	}
+	if (CheckContext{ContextualTuples: []Tuple{{Object: "project:abc", Relation: "viewer", User: "user:alice"}}}).IsZero() {
+		t.Error("expected check context with contextual tuples not to be zero")
+	}
+}
+
+func TestCheckContext_Validate(t *testing.T) {
+	valid := Tuple{Object: "project:abc", Relation: "viewer", User: "user:bob"}
+	checks := []Tuple{{Object: "project:abc", Relation: "viewer", User: "user:alice"}}
+	if err := (CheckContext{ContextualTuples: []Tuple{valid}}).Validate(checks); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err := CheckContext{ContextualTuples: []Tuple{
+		valid,
+		{Object: "project:abc#owner", Relation: "viewer", User: "user:alice"},
+		{Object: "project:abc", Relation: "viewer", User: "alice"},
+	}}.Validate(checks)
+	if err == nil {
+		t.Fatal("expected error for invalid contextual tuples")
+	}
+	for _, want := range []string{"contextual_tuples[1]", "contextual_tuples[2]"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error to name %s, got %v", want, err)
+		}
+	}
+
+	tooMany := make([]Tuple, constants.MaxContextualTuples+1)
+	for i := range tooMany {
+		tooMany[i] = valid
+	}
+	if err := (CheckContext{ContextualTuples: tooMany}).Validate(checks); err == nil {
+		t.Error("expected error for too many contextual tuples")
+	}
+}
+
+func TestCheckContext_ValidateRejectsSelfGrants(t *testing.T) {
+	checks := []Tuple{{Object: "project:abc", Relation: "auditor", User: "user:alice"}}
+
+	tests := []struct {
+		name  string
+		tuple Tuple
+		want  string
+	}{
+		{
+			name:  "checked tuple",
+			tuple: Tuple{Object: "project:abc", Relation: "auditor", User: "user:alice"},
+			want:  "must not state a checked tuple",
+		},
+		{
+			name:  "checked user on another relation",
+			tuple: Tuple{Object: "project:abc", Relation: "owner", User: "user:alice"},
+			want:  "must not grant a relation to the checked user",
+		},
+		{
+			name:  "checked user joining a group",
+			tuple: Tuple{Object: "team:core", Relation: "member", User: "user:alice"},
+			want:  "must not grant a relation to the checked user",
+		},
+		{
+			name:  "wildcard user",
+			tuple: Tuple{Object: "project:abc", Relation: "auditor", User: "user:*"},
+			want:  "user must be a single principal",
+		},
+		{
+			name:  "userset the checked user may belong to",
+			tuple: Tuple{Object: "project:abc", Relation: "auditor", User: "team:core#member"},
+			want:  "user must be a single principal",
+		},
+		{
+			name:  "parent link",
+			tuple: Tuple{Object: "project:abc", Relation: "parent", User: "project:xyz"},
+			want:  "user must be a single principal",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckContext{ContextualTuples: []Tuple{tc.tuple}}.Validate(checks)
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("Validate() = %v; want error %q", err, tc.want)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ package contracts
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
 )
@@ -15,6 +16,14 @@ import (
 type HeimdallClaims struct {
 	Principal string `json:"principal"`
 	Email     string `json:"email,omitempty"`
+	// ClientID identifies the OAuth client the token was issued to, when
+	// Heimdall includes it.
+	ClientID string `json:"client_id,omitempty"`
+	// Audience is copied from the validated registered "aud" claim.
+	Audience []string `json:"-"`
+	// ExpiresAt is copied from the validated registered "exp" claim; it is
+	// zero when the token does not expire.
+	ExpiresAt time.Time `json:"-"`
 }
 
 // Validate provides validation of HeimdallClaims
@@ -9,9 +9,23 @@ import (
 	"time"
 )
 
+// MessageHandler processes a message received on a subscribed subject. ctx
+// carries the trace context propagated by the publisher.
+type MessageHandler func(ctx context.Context, subject string, data []byte)
+
 // MessagingRepository handles NATS communication
 type MessagingRepository interface {
 	Request(ctx context.Context, subject string, data []byte, timeout time.Duration) ([]byte, error)
+	// Subscribe delivers every message published on subject to handler until
+	// the repository is closed.
+	Subscribe(subject string, handler MessageHandler) error
 	Close() error
 	HealthCheck(ctx context.Context) error
 }
+
+// RetryAfterError is implemented by errors for requests that were refused
+// without being attempted and may be retried after a known delay.
+type RetryAfterError interface {
+	error
+	RetryAfter() time.Duration
+}
@@ -0,0 +1,20 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package contracts
+
+import (
+	"context"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
+)
+
+// RateLimitStore keeps the token-bucket state of rate-limit keys. A store
+// shared by all replicas (e.g. a NATS key-value bucket) makes the limits apply
+// to the deployment as a whole instead of to each replica.
+type RateLimitStore interface {
+	// Take takes one token from the bucket of key under limit and reports
+	// whether the request is allowed.
+	Take(ctx context.Context, key string, limit domain.RateLimit) (domain.RateLimitDecision, error)
+	Close() error
+}
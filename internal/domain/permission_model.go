@@ -0,0 +1,72 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// PermissionModel lists, per object type, the relations a caller can hold on
+// an object of that type. It tells the service which relations to check when
+// computing a caller's effective permissions on an object.
+type PermissionModel map[string][]string
+
+// DefaultPermissionModel returns the model used when no model description is
+// configured.
+func DefaultPermissionModel() PermissionModel {
+	return PermissionModel{
+		"project":   {"writer", "auditor", "viewer"},
+		"committee": {"writer", "member", "viewer"},
+	}
+}
+
+// ParsePermissionModel decodes a JSON model description of the form
+// {"project": ["owner", "writer", "viewer"], ...} and validates every object
+// type and relation against the check grammar.
+func ParsePermissionModel(data []byte) (PermissionModel, error) {
+	var model PermissionModel
+	if err := json.Unmarshal(data, &model); err != nil {
+		return nil, fmt.Errorf("invalid permission model: %w", err)
+	}
+	if err := model.Validate(); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// Validate checks that the model names at least one object type, that every
+// object type has at least one relation, and that no relation is repeated.
+func (m PermissionModel) Validate() error {
+	if len(m) == 0 {
+		return errors.New("invalid permission model: no object types")
+	}
+	for objectType, relations := range m {
+		if err := ValidateObjectType(objectType); err != nil {
+			return fmt.Errorf("invalid permission model: %w", err)
+		}
+		if len(relations) == 0 {
+			return fmt.Errorf("invalid permission model: object type %q has no relations", objectType)
+		}
+		seen := make(map[string]struct{}, len(relations))
+		for _, relation := range relations {
+			if err := ValidateRelation(relation); err != nil {
+				return fmt.Errorf("invalid permission model: %s: %w", objectType, err)
+			}
+			if _, dup := seen[relation]; dup {
+				return fmt.Errorf("invalid permission model: %s: duplicate relation %q", objectType, relation)
+			}
+			seen[relation] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// Relations returns the relations defined for objectType, in model order, and
+// whether the type is part of the model.
+func (m PermissionModel) Relations(objectType string) ([]string, bool) {
+	relations, ok := m[objectType]
+	return relations, ok
+}
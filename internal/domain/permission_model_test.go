@@ -0,0 +1,55 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParsePermissionModel(t *testing.T) {
+	model, err := ParsePermissionModel([]byte(`{"project":["writer","viewer"],"past_meeting":["participant"]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	relations, ok := model.Relations("project")
+	if !ok || !slices.Equal(relations, []string{"writer", "viewer"}) {
+		t.Errorf("unexpected project relations %v (ok=%v)", relations, ok)
+	}
+	if _, ok := model.Relations("committee"); ok {
+		t.Error("expected committee to be absent from the model")
+	}
+}
+
+func TestParsePermissionModel_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "not JSON", input: `project: [writer]`, wantErr: "invalid permission model"},
+		{name: "empty", input: `{}`, wantErr: "no object types"},
+		{name: "uppercase type", input: `{"Project":["writer"]}`, wantErr: "object type"},
+		{name: "type with id", input: `{"project:abc":["writer"]}`, wantErr: "object type"},
+		{name: "no relations", input: `{"project":[]}`, wantErr: "no relations"},
+		{name: "invalid relation", input: `{"project":["writer@user:bob"]}`, wantErr: "must match"},
+		{name: "duplicate relation", input: `{"project":["writer","writer"]}`, wantErr: "duplicate relation"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParsePermissionModel([]byte(tc.input))
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDefaultPermissionModel_Valid(t *testing.T) {
+	if err := DefaultPermissionModel().Validate(); err != nil {
+		t.Errorf("default permission model is invalid: %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+// RateLimit is a token-bucket limit: a caller may make Burst requests at once,
+// and the bucket refills at Rate requests per second. A non-positive Rate
+// means the caller is not limited.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// Unlimited reports whether the limit lets every request through.
+func (l RateLimit) Unlimited() bool {
+	return l.Rate <= 0
+}
+
+// RateLimitDecision is the outcome of taking a token for one request.
+type RateLimitDecision struct {
+	Allowed bool
+	// Limit is the burst size of the bucket.
+	Limit int
+	// Remaining is the number of whole tokens left after this request.
+	Remaining int
+	// Reset is how long until the bucket is full again.
+	Reset time.Duration
+	// RetryAfter is how long until a token is available; zero when Allowed.
+	RetryAfter time.Duration
+}
+
+// TokenBucket is the stored state of one rate-limit key. The zero value is a
+// bucket that has not been used yet, which starts full.
+type TokenBucket struct {
+	Tokens  float64   `json:"tokens"`
+	Updated time.Time `json:"updated"`
+}
+
+// Take refills the bucket for the time elapsed since it was last updated and
+// takes one token if one is available. It returns the new bucket state and
+// the decision.
+func (b TokenBucket) Take(limit RateLimit, now time.Time) (TokenBucket, RateLimitDecision) {
+	burst := float64(limit.Burst)
+	tokens := burst
+	if !b.Updated.IsZero() {
+		elapsed := max(now.Sub(b.Updated).Seconds(), 0)
+		tokens = math.Min(b.Tokens+elapsed*limit.Rate, burst)
+	}
+
+	decision := RateLimitDecision{Limit: limit.Burst}
+	if tokens >= 1 {
+		tokens--
+		decision.Allowed = true
+	} else {
+		decision.RetryAfter = secondsToDuration((1 - tokens) / limit.Rate)
+	}
+	decision.Remaining = int(math.Floor(tokens))
+	decision.Reset = secondsToDuration((burst - tokens) / limit.Rate)
+
+	return TokenBucket{Tokens: tokens, Updated: now}, decision
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// ParseRateLimitOverrides decodes per-principal rate limits of the form
+// "alice=5:10,ci-bot=0". Each entry is principal=rate[:burst]; a missing
+// burst keeps defaultBurst and a rate of 0 exempts the principal.
+func ParseRateLimitOverrides(value string, defaultBurst int) (map[string]RateLimit, error) {
+	overrides := make(map[string]RateLimit)
+	for entry := range strings.SplitSeq(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		sep := strings.LastIndex(entry, "=")
+		if sep <= 0 {
+			return nil, fmt.Errorf("%w: %q: expected principal=rate[:burst]", constants.ErrInvalidRateLimitRule, entry)
+		}
+		principal, spec := strings.TrimSpace(entry[:sep]), entry[sep+1:]
+
+		rateText, burstText, hasBurst := strings.Cut(spec, ":")
+		rate, err := strconv.ParseFloat(strings.TrimSpace(rateText), 64)
+		if err != nil || rate < 0 || math.IsInf(rate, 0) || math.IsNaN(rate) {
+			return nil, fmt.Errorf("%w: %q: rate must be a non-negative number", constants.ErrInvalidRateLimitRule, entry)
+		}
+		limit := RateLimit{Rate: rate, Burst: defaultBurst}
+		if hasBurst {
+			limit.Burst, err = strconv.Atoi(strings.TrimSpace(burstText))
+			if err != nil || limit.Burst < 1 {
+				return nil, fmt.Errorf("%w: %q: burst must be a positive integer", constants.ErrInvalidRateLimitRule, entry)
+			}
+		}
+
+		if _, dup := overrides[principal]; dup {
+			return nil, fmt.Errorf("%w: %q: principal listed twice", constants.ErrInvalidRateLimitRule, entry)
+		}
+		overrides[principal] = limit
+	}
+	return overrides, nil
+}
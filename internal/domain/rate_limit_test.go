@@ -0,0 +1,98 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"errors"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+func TestTokenBucket_Take(t *testing.T) {
+	limit := RateLimit{Rate: 2, Burst: 3}
+	start := time.Unix(1700000000, 0)
+
+	var bucket TokenBucket
+	var decision RateLimitDecision
+	for i := range 3 {
+		bucket, decision = bucket.Take(limit, start)
+		if !decision.Allowed {
+			t.Fatalf("request %d within the burst was refused", i+1)
+		}
+		if decision.Limit != 3 || decision.Remaining != 2-i {
+			t.Errorf("request %d: limit %d remaining %d; want 3 and %d", i+1, decision.Limit, decision.Remaining, 2-i)
+		}
+	}
+
+	bucket, decision = bucket.Take(limit, start)
+	if decision.Allowed {
+		t.Fatal("request over the burst was allowed")
+	}
+	if decision.RetryAfter != 500*time.Millisecond {
+		t.Errorf("RetryAfter = %v; want 500ms", decision.RetryAfter)
+	}
+	if decision.Reset != 1500*time.Millisecond {
+		t.Errorf("Reset = %v; want 1.5s", decision.Reset)
+	}
+
+	// Half a second refills one token at two per second.
+	_, decision = bucket.Take(limit, start.Add(500*time.Millisecond))
+	if !decision.Allowed || decision.Remaining != 0 {
+		t.Errorf("after refill: allowed %v remaining %d; want true and 0", decision.Allowed, decision.Remaining)
+	}
+
+	// A long idle period refills no more than the burst.
+	_, decision = bucket.Take(limit, start.Add(time.Hour))
+	if !decision.Allowed || decision.Remaining != 2 {
+		t.Errorf("after idle: allowed %v remaining %d; want true and 2", decision.Allowed, decision.Remaining)
+	}
+}
+
+func TestParseRateLimitOverrides(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    map[string]RateLimit
+		wantErr bool
+	}{
+		{name: "empty", input: "", want: map[string]RateLimit{}},
+		{
+			name:  "rate and burst",
+			input: "alice=5:10, ci-bot=0",
+			want: map[string]RateLimit{
+				"alice":  {Rate: 5, Burst: 10},
+				"ci-bot": {Rate: 0, Burst: 20},
+			},
+		},
+		{name: "fractional rate keeps default burst", input: "bob=0.5", want: map[string]RateLimit{"bob": {Rate: 0.5, Burst: 20}}},
+		{name: "principal containing equals", input: "a=b=1", want: map[string]RateLimit{"a=b": {Rate: 1, Burst: 20}}},
+		{name: "missing rate", input: "alice", wantErr: true},
+		{name: "missing principal", input: "=5", wantErr: true},
+		{name: "negative rate", input: "alice=-1", wantErr: true},
+		{name: "zero burst", input: "alice=1:0", wantErr: true},
+		{name: "non-numeric burst", input: "alice=1:many", wantErr: true},
+		{name: "duplicate principal", input: "alice=1,alice=2", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseRateLimitOverrides(tc.input, 20)
+			if tc.wantErr {
+				if !errors.Is(err, constants.ErrInvalidRateLimitRule) {
+					t.Errorf("expected ErrInvalidRateLimitRule, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !maps.Equal(got, tc.want) {
+				t.Errorf("got %v; want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+// Package domain defines the core domain types of the access check service.
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+var (
+	// objectPattern is the strict "type:id" grammar for objects in check requests.
+	// None of the tuple delimiters ('@', '#', ':') or whitespace can appear in
+	// the id, so an object cannot smuggle in a relation or a user.
+	objectPattern = regexp.MustCompile(constants.ObjectPattern)
+
+	// objectTypePattern is the grammar for the type part of an object.
+	objectTypePattern = regexp.MustCompile(constants.ObjectTypePattern)
+
+	// relationPattern is the strict grammar for relation names.
+	relationPattern = regexp.MustCompile(constants.RelationPattern)
+)
+
+// Tuple is an OpenFGA relationship tuple: User holds Relation on Object.
+//
+// Object is in "type:id" form. User is either a typed user ("user:auth0|alice")
+// or a userset ("team:xyz#member").
+type Tuple struct {
+	Object   string
+	Relation string
+	User     string
+}
+
+// ParseTuple parses an "object#relation@user" tuple-string as returned by
+// fga-sync. The object is split off at the first '#' and the relation at the
+// following '@', so usersets such as "team:xyz#member" are kept intact in User.
+func ParseTuple(s string) (Tuple, error) {
+	object, rest, ok := strings.Cut(s, constants.ObjectRelationSeparator)
+	if !ok {
+		return Tuple{}, fmt.Errorf("%w: %q: missing relation", constants.ErrInvalidTuple, s)
+	}
+	relation, user, ok := strings.Cut(rest, constants.RelationSeparator)
+	if !ok {
+		return Tuple{}, fmt.Errorf("%w: %q: missing user", constants.ErrInvalidTuple, s)
+	}
+	if objectType, objectID, _ := strings.Cut(object, ":"); objectType == "" || objectID == "" {
+		return Tuple{}, fmt.Errorf("%w: %q: object must be type:id", constants.ErrInvalidTuple, s)
+	}
+	if relation == "" || user == "" {
+		return Tuple{}, fmt.Errorf("%w: %q: empty relation or user", constants.ErrInvalidTuple, s)
+	}
+	return Tuple{Object: object, Relation: relation, User: user}, nil
+}
+
+// ParseObjectRelation parses a strict "type:id#relation" check request into a
+// Tuple with an empty User. The returned error describes which part of the
+// grammar was violated.
+func ParseObjectRelation(s string) (Tuple, error) {
+	if s == "" {
+		return Tuple{}, errors.New("must not be empty")
+	}
+	object, relation, _ := strings.Cut(s, constants.ObjectRelationSeparator)
+	t := Tuple{Object: object, Relation: relation}
+	if err := t.validateObjectRelation(); err != nil {
+		return Tuple{}, err
+	}
+	return t, nil
+}
+
+// NewUser returns the OpenFGA user for an authenticated principal. Principals
+// containing whitespace or control characters are rejected because they could
+// break out of a plaintext access-check line.
+func NewUser(principal string) (string, error) {
+	if principal == "" {
+		return "", constants.ErrPrincipalRequired
+	}
+	if HasUnsafeRune(principal) {
+		return "", constants.ErrInvalidPrincipal
+	}
+	return constants.UserTypePrefix + principal, nil
+}
+
+// WithUser returns a copy of t checked for user.
+func (t Tuple) WithUser(user string) Tuple {
+	t.User = user
+	return t
+}
+
+// ObjectType returns the type part of the tuple's "type:id" object.
+func (t Tuple) ObjectType() string {
+	objectType, _, _ := strings.Cut(t.Object, ":")
+	return objectType
+}
+
+// ObjectRelation formats the tuple without its user as "object#relation".
+func (t Tuple) ObjectRelation() string {
+	return t.Object + constants.ObjectRelationSeparator + t.Relation
+}
+
+// String formats the tuple as "object#relation@user".
+func (t Tuple) String() string {
+	return t.ObjectRelation() + constants.RelationSeparator + t.User
+}
+
+// Validate checks that the tuple is safe to send as an access check: object
+// and relation follow the strict check grammar and the user is non-empty and
+// free of whitespace and control characters.
+func (t Tuple) Validate() error {
+	if err := t.validateObjectRelation(); err != nil {
+		return err
+	}
+	return ValidateUser(t.User)
+}
+
+// ValidateObject checks an object against the strict "type:id" grammar and
+// OpenFGA's length limit.
+func ValidateObject(object string) error {
+	if len(object) > constants.MaxObjectLength {
+		return fmt.Errorf("object exceeds %d characters", constants.MaxObjectLength)
+	}
+	if !objectPattern.MatchString(object) {
+		return fmt.Errorf("object %q must match %s", object, constants.ObjectPattern)
+	}
+	return nil
+}
+
+// ValidateObjectType checks an object type against the type part of the
+// object grammar.
+func ValidateObjectType(objectType string) error {
+	if !objectTypePattern.MatchString(objectType) {
+		return fmt.Errorf("object type %q must match %s", objectType, constants.ObjectTypePattern)
+	}
+	return nil
+}
+
+// ValidateRelation checks a relation name against the strict relation grammar
+// and OpenFGA's length limit.
+func ValidateRelation(relation string) error {
+	if len(relation) > constants.MaxRelationLength {
+		return fmt.Errorf("relation exceeds %d characters", constants.MaxRelationLength)
+	}
+	if !relationPattern.MatchString(relation) {
+		return fmt.Errorf("relation %q must match %s", relation, constants.RelationPattern)
+	}
+	return nil
+}
+
+// ValidateUser checks that user is a typed OpenFGA user ("user:auth0|alice",
+// "user:*") or userset ("team:xyz#member") free of whitespace and control
+// characters.
+func ValidateUser(user string) error {
+	if user == "" {
+		return errors.New("user must not be empty")
+	}
+	if HasUnsafeRune(user) {
+		return errors.New("user contains whitespace or control characters")
+	}
+	if userType, id, ok := strings.Cut(user, ":"); !ok || userType == "" || id == "" {
+		return fmt.Errorf("user %q must be type:id", user)
+	}
+	return nil
+}
+
+// IsUserset reports whether user is a userset ("team:xyz#member") rather than
+// a single user.
+func IsUserset(user string) bool {
+	return strings.Contains(user, constants.ObjectRelationSeparator)
+}
+
+// IsPrincipal reports whether user is a single principal ("user:auth0|alice")
+// rather than a userset, the "user:*" wildcard, or another object.
+func IsPrincipal(user string) bool {
+	id, ok := strings.CutPrefix(user, constants.UserTypePrefix)
+	return ok && id != "" && id != "*" && !IsUserset(user)
+}
+
+// validateObjectRelation enforces the "type:id#relation" grammar and OpenFGA's
+// field length limits.
+func (t Tuple) validateObjectRelation() error {
+	if len(t.Object) > constants.MaxObjectLength {
+		return fmt.Errorf("object exceeds %d characters", constants.MaxObjectLength)
+	}
+	if len(t.Relation) > constants.MaxRelationLength {
+		return fmt.Errorf("relation exceeds %d characters", constants.MaxRelationLength)
+	}
+	if !objectPattern.MatchString(t.Object) || !relationPattern.MatchString(t.Relation) {
+		return errors.New("must match type:id#relation")
+	}
+	return nil
+}
+
+// HasUnsafeRune reports whether s contains whitespace or a control character,
+// either of which could alter the framing of a plaintext payload. Principals,
+// principal prefixes and tuples share this rule.
+func HasUnsafeRune(s string) bool {
+	return strings.IndexFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsControl(r)
+	}) >= 0
+}
+
+// CheckResult is the decision fga-sync returned for a single tuple.
+type CheckResult struct {
+	Tuple
+	Allowed bool
+	// Stale is set when fga-sync was unavailable and the decision was served
+	// from an expired cache entry or failed closed instead.
+	Stale bool
+	// Contextual is set when the decision was made with the call's
+	// contextual tuples rather than stored tuples alone.
+	Contextual bool
+}
+
+// ParseCheckResult parses an "object#relation@user\t{true|false}" result line.
+func ParseCheckResult(line string) (CheckResult, error) {
+	tuple, decision, ok := strings.Cut(line, constants.ResultFieldSeparator)
+	if !ok {
+		return CheckResult{}, fmt.Errorf("%w: %q: missing decision", constants.ErrInvalidTuple, line)
+	}
+	t, err := ParseTuple(tuple)
+	if err != nil {
+		return CheckResult{}, err
+	}
+	switch decision {
+	case constants.AccessTrue:
+		return CheckResult{Tuple: t, Allowed: true}, nil
+	case constants.AccessFalse:
+		return CheckResult{Tuple: t, Allowed: false}, nil
+	default:
+		return CheckResult{}, fmt.Errorf("%w: %q: decision must be %s or %s", constants.ErrInvalidTuple, line, constants.AccessTrue, constants.AccessFalse)
+	}
+}
+
+// String formats the result as "object#relation@user\t{true|false}".
+func (r CheckResult) String() string {
+	decision := constants.AccessFalse
+	if r.Allowed {
+		decision = constants.AccessTrue
+	}
+	return r.Tuple.String() + constants.ResultFieldSeparator + decision
+}
@@ -0,0 +1,295 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+func TestParseTuple_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected Tuple
+	}{
+		{
+			name:     "typed user",
+			input:    "project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#auditor@user:auth0|alice",
+			expected: Tuple{Object: "project:a27394a3-7a6c-4d0f-9e0f-692d8753924f", Relation: "auditor", User: "user:auth0|alice"},
+		},
+		{
+			name:     "userset",
+			input:    "committee:xyz#writer@team:core#member",
+			expected: Tuple{Object: "committee:xyz", Relation: "writer", User: "team:core#member"},
+		},
+		{
+			name:     "user containing @",
+			input:    "project:abc#viewer@user:alice@example.com",
+			expected: Tuple{Object: "project:abc", Relation: "viewer", User: "user:alice@example.com"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseTuple(tc.input)
+			if err != nil {
+				t.Fatalf("ParseTuple(%q) failed: %v", tc.input, err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %+v, got %+v", tc.expected, got)
+			}
+			if s := got.String(); s != tc.input {
+				t.Errorf("round trip: expected %q, got %q", tc.input, s)
+			}
+		})
+	}
+}
+
+func TestParseTuple_Invalid(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"project:abc",
+		"project:abc#viewer",
+		"project:abc#@user:alice",
+		"project:abc#viewer@",
+		"project#viewer@user:alice",
+		":abc#viewer@user:alice",
+		"project:#viewer@user:alice",
+	} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParseTuple(input); !errors.Is(err, constants.ErrInvalidTuple) {
+				t.Errorf("expected ErrInvalidTuple for %q, got %v", input, err)
+			}
+		})
+	}
+}
+
+func TestParseObjectRelation(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "uuid object", input: "project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#auditor"},
+		{name: "snake case type and relation", input: "past_meeting:m-1#can_view"},
+		{name: "empty", input: "", wantErr: "must not be empty"},
+		{name: "missing relation", input: "project:abc", wantErr: "must match"},
+		{name: "missing id", input: "project:#viewer", wantErr: "must match"},
+		{name: "uppercase relation", input: "project:abc#Viewer", wantErr: "must match"},
+		{name: "newline", input: "project:abc#viewer\nproject:xyz#owner", wantErr: "must match"},
+		{name: "user suffix", input: "project:abc#viewer@user:bob", wantErr: "must match"},
+		{name: "stray hash", input: "project:abc#viewer#owner", wantErr: "must match"},
+		{name: "extra colon", input: "project:abc:def#viewer", wantErr: "must match"},
+		{name: "whitespace", input: "project:abc #viewer", wantErr: "must match"},
+		{name: "object too long", input: "project:" + strings.Repeat("a", constants.MaxObjectLength) + "#viewer", wantErr: "object exceeds"},
+		{name: "relation too long", input: "project:abc#" + strings.Repeat("a", constants.MaxRelationLength+1), wantErr: "relation exceeds"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseObjectRelation(tc.input)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.User != "" {
+				t.Errorf("expected empty user, got %q", got.User)
+			}
+			if s := got.ObjectRelation(); s != tc.input {
+				t.Errorf("round trip: expected %q, got %q", tc.input, s)
+			}
+		})
+	}
+}
+
+func TestNewUser(t *testing.T) {
+	user, err := NewUser("auth0|alice")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user != "user:auth0|alice" {
+		t.Errorf("expected %q, got %q", "user:auth0|alice", user)
+	}
+
+	if _, err := NewUser(""); !errors.Is(err, constants.ErrPrincipalRequired) {
+		t.Errorf("expected ErrPrincipalRequired, got %v", err)
+	}
+	if _, err := NewUser("alice\nproject:xyz#owner@user:bob"); !errors.Is(err, constants.ErrInvalidPrincipal) {
+		t.Errorf("expected ErrInvalidPrincipal, got %v", err)
+	}
+}
+
+func TestTuple_Validate(t *testing.T) {
+	valid := Tuple{Object: "project:abc", Relation: "viewer", User: "user:alice"}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("expected valid tuple, got %v", err)
+	}
+
+	for name, tuple := range map[string]Tuple{
+		"empty user":           {Object: "project:abc", Relation: "viewer"},
+		"newline in user":      {Object: "project:abc", Relation: "viewer", User: "user:alice\nx"},
+		"relation with user":   {Object: "project:abc", Relation: "viewer@user:bob", User: "user:alice"},
+		"object with relation": {Object: "project:abc#owner", Relation: "viewer", User: "user:alice"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := tuple.Validate(); err == nil {
+				t.Errorf("expected %+v to be invalid", tuple)
+			}
+		})
+	}
+}
+
+func TestTuple_ObjectType(t *testing.T) {
+	tuple := Tuple{Object: "past_meeting:m-1", Relation: "viewer", User: "user:alice"}
+	if got := tuple.ObjectType(); got != "past_meeting" {
+		t.Errorf("expected %q, got %q", "past_meeting", got)
+	}
+}
+
+func TestParseCheckResult_RoundTrip(t *testing.T) {
+	for _, line := range []string{
+		"project:abc#auditor@user:auth0|alice\ttrue",
+		"committee:xyz#writer@user:auth0|alice\tfalse",
+	} {
+		t.Run(line, func(t *testing.T) {
+			result, err := ParseCheckResult(line)
+			if err != nil {
+				t.Fatalf("ParseCheckResult(%q) failed: %v", line, err)
+			}
+			if result.Allowed != strings.HasSuffix(line, "\ttrue") {
+				t.Errorf("unexpected decision %v for %q", result.Allowed, line)
+			}
+			if s := result.String(); s != line {
+				t.Errorf("round trip: expected %q, got %q", line, s)
+			}
+		})
+	}
+}
+
+func TestParseCheckResult_Invalid(t *testing.T) {
+	for _, line := range []string{
+		"true",
+		"project:abc#auditor@user:alice",
+		"project:abc#auditor@user:alice\tmaybe",
+		"project:abc\ttrue",
+	} {
+		t.Run(line, func(t *testing.T) {
+			if _, err := ParseCheckResult(line); !errors.Is(err, constants.ErrInvalidTuple) {
+				t.Errorf("expected ErrInvalidTuple for %q, got %v", line, err)
+			}
+		})
+	}
+}
+
+func TestValidateObject(t *testing.T) {
+	for object, valid := range map[string]bool{
+		"project:abc":         true,
+		"project_group:a.b-c": true,
+		"":                    false,
+		"project":             false,
+		"project:":            false,
+		"Project:abc":         false,
+		"project:abc#viewer":  false,
+		"project:abc def":     false,
+		"project:" + strings.Repeat("a", constants.MaxObjectLength): false,
+	} {
+		t.Run(object, func(t *testing.T) {
+			if err := ValidateObject(object); (err == nil) != valid {
+				t.Errorf("ValidateObject(%q) = %v, want valid=%v", object, err, valid)
+			}
+		})
+	}
+}
+
+func TestValidateObjectType(t *testing.T) {
+	for objectType, valid := range map[string]bool{
+		"project":       true,
+		"project_group": true,
+		"v2_project":    true,
+		"":              false,
+		"Project":       false,
+		"2project":      false,
+		"project:abc":   false,
+	} {
+		t.Run(objectType, func(t *testing.T) {
+			if err := ValidateObjectType(objectType); (err == nil) != valid {
+				t.Errorf("ValidateObjectType(%q) = %v, want valid=%v", objectType, err, valid)
+			}
+		})
+	}
+}
+
+func TestValidateRelation(t *testing.T) {
+	for relation, valid := range map[string]bool{
+		"writer":          true,
+		"can_view":        true,
+		"":                false,
+		"Writer":          false,
+		"writer@user:bob": false,
+		"writer#member":   false,
+		strings.Repeat("a", constants.MaxRelationLength+1): false,
+	} {
+		t.Run(relation, func(t *testing.T) {
+			if err := ValidateRelation(relation); (err == nil) != valid {
+				t.Errorf("ValidateRelation(%q) = %v, want valid=%v", relation, err, valid)
+			}
+		})
+	}
+}
+
+func TestValidateUser(t *testing.T) {
+	for user, valid := range map[string]bool{
+		"user:auth0|alice": true,
+		"user:*":           true,
+		"team:core#member": true,
+		"":                 false,
+		"alice":            false,
+		"user:":            false,
+		":alice":           false,
+		"user:alice bob":   false,
+	} {
+		t.Run(user, func(t *testing.T) {
+			if err := ValidateUser(user); (err == nil) != valid {
+				t.Errorf("ValidateUser(%q) = %v, want valid=%v", user, err, valid)
+			}
+		})
+	}
+}
+
+func TestIsUserset(t *testing.T) {
+	if IsUserset("user:auth0|alice") {
+		t.Error("expected typed user not to be a userset")
+	}
+	if !IsUserset("team:core#member") {
+		t.Error("expected team:core#member to be a userset")
+	}
+}
+
+func TestIsPrincipal(t *testing.T) {
+	tests := []struct {
+		user string
+		want bool
+	}{
+		{"user:auth0|alice", true},
+		{"user:*", false},
+		{"user:", false},
+		{"team:core#member", false},
+		{"user:alice#member", false},
+		{"project:abc", false},
+	}
+	for _, tt := range tests {
+		if got := IsPrincipal(tt.user); got != tt.want {
+			t.Errorf("IsPrincipal(%q) = %v, want %v", tt.user, got, tt.want)
+		}
+	}
+}
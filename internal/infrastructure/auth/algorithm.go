@@ -0,0 +1,85 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/auth0/go-jwt-middleware/v2/validator"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+	jose "gopkg.in/go-jose/go-jose.v2"
+)
+
+// supportedAlgorithms maps each signing algorithm an issuer may be trusted
+// with to a check of the public key type it signs with. "none" and the HMAC
+// algorithms are deliberately absent: a JWKS is public, so a token "signed"
+// with a published key as HMAC secret would prove nothing.
+var supportedAlgorithms = map[string]func(key any) bool{
+	string(validator.RS256): isRSAKey,
+	string(validator.RS384): isRSAKey,
+	string(validator.RS512): isRSAKey,
+	string(validator.PS256): isRSAKey,
+	string(validator.PS384): isRSAKey,
+	string(validator.PS512): isRSAKey,
+	string(validator.ES256): isECKey(elliptic.P256()),
+	string(validator.ES384): isECKey(elliptic.P384()),
+	string(validator.ES512): isECKey(elliptic.P521()),
+	string(validator.EdDSA): isEd25519Key,
+}
+
+// checkAlgorithm reports an error unless alg is a supported asymmetric
+// signing algorithm.
+func checkAlgorithm(alg string) error {
+	if _, ok := supportedAlgorithms[alg]; !ok {
+		return fmt.Errorf("%w %q: only RS*, PS*, ES* and EdDSA are accepted", constants.ErrUnsupportedAlgorithm, alg)
+	}
+	return nil
+}
+
+func isRSAKey(key any) bool {
+	_, ok := key.(*rsa.PublicKey)
+	return ok
+}
+
+func isECKey(curve elliptic.Curve) func(key any) bool {
+	return func(key any) bool {
+		ec, ok := key.(*ecdsa.PublicKey)
+		return ok && ec.Curve == curve
+	}
+}
+
+func isEd25519Key(key any) bool {
+	_, ok := key.(ed25519.PublicKey)
+	return ok
+}
+
+// publicKeys wraps a JWKS key func so that only the public keys alg signs
+// with are offered for verification: symmetric keys, keys of another type
+// and keys pinned to another algorithm are dropped.
+func publicKeys(keyFunc func(context.Context) (any, error), alg string) func(context.Context) (any, error) {
+	fits := supportedAlgorithms[alg]
+	return func(ctx context.Context) (any, error) {
+		keys, err := keyFunc(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set, ok := keys.(*jose.JSONWebKeySet)
+		if !ok {
+			return nil, fmt.Errorf("%w: unexpected key set type %T", constants.ErrUnexpectedResponse, keys)
+		}
+
+		filtered := &jose.JSONWebKeySet{Keys: make([]jose.JSONWebKey, 0, len(set.Keys))}
+		for _, key := range set.Keys {
+			if key.IsPublic() && fits(key.Key) && (key.Algorithm == "" || key.Algorithm == alg) {
+				filtered.Keys = append(filtered.Keys, key)
+			}
+		}
+		return filtered, nil
+	}
+}
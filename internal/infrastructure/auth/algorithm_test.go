@@ -0,0 +1,195 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+	jose "gopkg.in/go-jose/go-jose.v2"
+)
+
+func TestValidateToken_SupportedAlgorithms(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate RSA key: %v", err)
+	}
+	ecKey := func(curve elliptic.Curve) *ecdsa.PrivateKey {
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			t.Fatalf("generate EC key: %v", err)
+		}
+		return key
+	}
+	p256, p384, p521 := ecKey(elliptic.P256()), ecKey(elliptic.P384()), ecKey(elliptic.P521())
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate Ed25519 key: %v", err)
+	}
+
+	tests := []struct {
+		alg     jose.SignatureAlgorithm
+		private any
+		public  any
+	}{
+		{jose.RS256, rsaKey, &rsaKey.PublicKey},
+		{jose.RS384, rsaKey, &rsaKey.PublicKey},
+		{jose.RS512, rsaKey, &rsaKey.PublicKey},
+		{jose.PS256, rsaKey, &rsaKey.PublicKey},
+		{jose.PS384, rsaKey, &rsaKey.PublicKey},
+		{jose.PS512, rsaKey, &rsaKey.PublicKey},
+		{jose.ES256, p256, &p256.PublicKey},
+		{jose.ES384, p384, &p384.PublicKey},
+		{jose.ES512, p521, &p521.PublicKey},
+		{jose.EdDSA, edPrivate, edPublic},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.alg), func(t *testing.T) {
+			issuer := newTestIssuer(t, tc.alg, tc.private, tc.public)
+			cfg := issuer.config("lfx-v2-access-check")
+			repo, err := NewAuthRepository(cfg.JWKSURL, cfg.Issuer, "lfx-v2-access-check", string(tc.alg))
+			if err != nil {
+				t.Fatalf("NewAuthRepository failed: %v", err)
+			}
+
+			claims, err := repo.ValidateToken(context.Background(), issuer.sign(t, "lfx-v2-access-check", map[string]any{"principal": "alice"}))
+			if err != nil {
+				t.Fatalf("ValidateToken failed: %v", err)
+			}
+			if claims.Principal != "alice" {
+				t.Errorf("principal = %q; want %q", claims.Principal, "alice")
+			}
+		})
+	}
+}
+
+func TestValidateToken_RejectedAlgorithms(t *testing.T) {
+	issuer := newRSATestIssuer(t, jose.RS256)
+	cfg := issuer.config("lfx-v2-access-check")
+	repo, err := NewAuthRepository(cfg.JWKSURL, cfg.Issuer, "lfx-v2-access-check", string(jose.RS256))
+	if err != nil {
+		t.Fatalf("NewAuthRepository failed: %v", err)
+	}
+
+	// The classic key confusion attack: HMAC with the published public key
+	// as the secret.
+	publicDER, err := x509.MarshalPKIXPublicKey(&issuer.key.(*rsa.PrivateKey).PublicKey)
+	if err != nil {
+		t.Fatalf("marshal public key: %v", err)
+	}
+	hmacForgery := *issuer
+	hmacForgery.alg = jose.HS256
+	hmacForgery.key = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+
+	otherAlgorithm := *issuer
+	otherAlgorithm.alg = jose.PS256
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "none", token: unsignedToken(t, cfg.Issuer, "lfx-v2-access-check")},
+		{name: "HS256 with the public key as secret", token: hmacForgery.sign(t, "lfx-v2-access-check", map[string]any{"principal": "mallory"})},
+		{name: "same key, algorithm not allowed", token: otherAlgorithm.sign(t, "lfx-v2-access-check", map[string]any{"principal": "mallory"})},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := repo.ValidateToken(context.Background(), tc.token)
+			if !errors.Is(err, constants.ErrAlgorithmNotAllowed) {
+				t.Errorf("err = %v; want %v", err, constants.ErrAlgorithmNotAllowed)
+			}
+		})
+	}
+}
+
+// unsignedToken builds an "alg": "none" token that is otherwise valid.
+func unsignedToken(t *testing.T, issuer, audience string) string {
+	t.Helper()
+	segment := func(v any) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("marshal token segment: %v", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	header := segment(map[string]string{"alg": "none", "typ": "JWT"})
+	claims := segment(map[string]any{
+		"iss":       issuer,
+		"aud":       audience,
+		"exp":       time.Now().Add(time.Hour).Unix(),
+		"principal": "mallory",
+	})
+	return header + "." + claims + "."
+}
+
+func TestNewAuthRepository_UnsupportedAlgorithms(t *testing.T) {
+	for _, alg := range []string{"none", "HS256", "HS384", "HS512", "rs256", ""} {
+		t.Run(alg, func(t *testing.T) {
+			_, err := NewAuthRepository("https://example.com/.well-known/jwks", "https://example.com", "test", alg)
+			if !errors.Is(err, constants.ErrUnsupportedAlgorithm) {
+				t.Errorf("err = %v; want %v", err, constants.ErrUnsupportedAlgorithm)
+			}
+		})
+	}
+}
+
+func TestPublicKeys(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate RSA key: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate EC key: %v", err)
+	}
+	set := &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: &rsaKey.PublicKey, KeyID: "rsa"},
+		{Key: &rsaKey.PublicKey, KeyID: "rsa-ps256", Algorithm: "PS256"},
+		{Key: rsaKey, KeyID: "rsa-private"},
+		{Key: &ecKey.PublicKey, KeyID: "ec-p384"},
+		{Key: []byte("shared-secret"), KeyID: "oct"},
+	}}
+	keyFunc := func(context.Context) (any, error) { return set, nil }
+
+	tests := []struct {
+		alg  string
+		want []string
+	}{
+		{alg: "RS256", want: []string{"rsa"}},
+		{alg: "PS256", want: []string{"rsa", "rsa-ps256"}},
+		{alg: "ES256", want: []string{}},
+		{alg: "ES384", want: []string{"ec-p384"}},
+		{alg: "EdDSA", want: []string{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.alg, func(t *testing.T) {
+			keys, err := publicKeys(keyFunc, tc.alg)(context.Background())
+			if err != nil {
+				t.Fatalf("publicKeys failed: %v", err)
+			}
+			got := []string{}
+			for _, key := range keys.(*jose.JSONWebKeySet).Keys {
+				got = append(got, key.KeyID)
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("keys = %v; want %v", got, tc.want)
+			}
+		})
+	}
+}
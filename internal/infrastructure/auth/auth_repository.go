@@ -6,46 +6,126 @@ package auth
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/auth0/go-jwt-middleware/v2/jwks"
 	"github.com/auth0/go-jwt-middleware/v2/validator"
 	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
 	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 )
 
-const (
-	signatureAlgorithm = validator.PS256
+// meter is safe to initialize at package level: otel.Meter() delegates to the
+// MeterProvider registered at call time.
+var meter = otel.Meter("github.com/linuxfoundation/lfx-v2-access-check/internal/infrastructure/auth")
+
+var issuerHealthy, _ = meter.Int64Gauge("access_check.auth.issuer.healthy",
+	metric.WithDescription("Whether the JWKS endpoint of a trusted issuer was reachable at the last readiness check (1) or not (0)"),
 )
 
+// trustedIssuer validates the tokens of one issuer.
+type trustedIssuer struct {
+	name     string
+	provider *jwks.CachingProvider
+	claims   ClaimMapping
+
+	// principalPrefix is prepended to the issuer's principals. Without one,
+	// reservedPrefixes lists the other issuers' prefixes, which the issuer's
+	// principals may not start with.
+	principalPrefix  string
+	reservedPrefixes []string
+
+	// validators holds one validator per accepted signing algorithm, since a
+	// validator accepts exactly one.
+	validators map[string]*validator.Validator
+}
+
 type authRepository struct {
-	validator *validator.Validator
+	// issuers maps each trusted "iss" value to its issuer; order keeps the
+	// configured order for health reporting.
+	issuers map[string]*trustedIssuer
+	order   []*trustedIssuer
+}
+
+// NewAuthRepository creates a new JWT-based authentication repository that
+// trusts a single issuer. algorithms lists the accepted signing algorithms and
+// defaults to constants.DefaultJWTAlgorithm.
+func NewAuthRepository(jwksURL, issuer, audience string, algorithms ...string) (contracts.AuthRepository, error) {
+	return NewTrustedIssuersAuthRepository([]IssuerConfig{{
+		Issuer:     issuer,
+		JWKSURL:    jwksURL,
+		Audiences:  []string{audience},
+		Algorithms: algorithms,
+	}})
+}
+
+// NewTrustedIssuersAuthRepository creates a JWT-based authentication
+// repository that accepts tokens from each of issuers, validated against the
+// issuer's own keys, audiences and algorithms.
+func NewTrustedIssuersAuthRepository(issuers []IssuerConfig) (contracts.AuthRepository, error) {
+	if len(issuers) == 0 {
+		return nil, fmt.Errorf("%w: no issuers listed", constants.ErrInvalidTrustedIssuer)
+	}
+	if err := checkPrincipalPrefixes(issuers); err != nil {
+		return nil, err
+	}
+
+	// Set up JWKS providers with an OTel-instrumented HTTP client
+	httpClient := &http.Client{
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
+	}
+
+	repo := &authRepository{issuers: make(map[string]*trustedIssuer, len(issuers))}
+	for _, cfg := range issuers {
+		issuer, err := newTrustedIssuer(cfg, httpClient)
+		if err != nil {
+			return nil, err
+		}
+		if _, dup := repo.issuers[issuer.name]; dup {
+			return nil, fmt.Errorf("%w: issuer %q is listed twice", constants.ErrInvalidTrustedIssuer, issuer.name)
+		}
+		repo.issuers[issuer.name] = issuer
+		repo.order = append(repo.order, issuer)
+	}
+	for _, issuer := range repo.order {
+		if issuer.principalPrefix != "" {
+			continue
+		}
+		for _, other := range repo.order {
+			if other.principalPrefix != "" {
+				issuer.reservedPrefixes = append(issuer.reservedPrefixes, other.principalPrefix)
+			}
+		}
+	}
+	return repo, nil
 }
 
-// NewAuthRepository creates a new JWT-based authentication repository
-func NewAuthRepository(jwksURL, issuer, audience string) (contracts.AuthRepository, error) {
-	slog.Info("Initializing auth repository", "jwks_url", jwksURL, "issuer", issuer)
+func newTrustedIssuer(cfg IssuerConfig, httpClient *http.Client) (*trustedIssuer, error) {
+	slog.Info("Initializing trusted issuer", "jwks_url", cfg.JWKSURL, "issuer", cfg.Issuer, "audiences", cfg.Audiences)
 
 	// Parse URLs
-	jwksU, err := url.Parse(jwksURL)
+	jwksU, err := url.Parse(cfg.JWKSURL)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse JWKS URL %s: %w", jwksURL, err)
+		return nil, fmt.Errorf("failed to parse JWKS URL %s: %w", cfg.JWKSURL, err)
 	}
 
-	issuerU, err := url.Parse(issuer)
+	issuerU, err := url.Parse(cfg.Issuer)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse issuer URL %s: %w", issuer, err)
+		return nil, fmt.Errorf("failed to parse issuer URL %s: %w", cfg.Issuer, err)
 	}
 
-	// Set up JWKS provider with OTel-instrumented HTTP client
-	httpClient := &http.Client{
-		Transport: otelhttp.NewTransport(http.DefaultTransport),
-	}
 	provider := jwks.NewCachingProvider(
 		issuerU,
 		constants.DefaultJWKSCacheTimeout,
@@ -53,33 +133,64 @@ func NewAuthRepository(jwksURL, issuer, audience string) (contracts.AuthReposito
 		jwks.WithCustomClient(httpClient),
 	)
 
-	// Factory for custom JWT claims
+	// Factory for the claims of a token, mapped to HeimdallClaims later
 	customClaims := func() validator.CustomClaims {
-		return &contracts.HeimdallClaims{}
+		return &tokenClaims{}
 	}
 
-	// Create JWT validator
-	jwtValidator, err := validator.New(
-		provider.KeyFunc,
-		signatureAlgorithm,
-		issuerU.String(),
-		[]string{audience},
-		validator.WithCustomClaims(customClaims),
-		validator.WithAllowedClockSkew(constants.JWTClockSkew),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create JWT validator for issuer %s: %w", issuer, err)
+	algorithms := cfg.Algorithms
+	if len(algorithms) == 0 {
+		algorithms = []string{constants.DefaultJWTAlgorithm}
 	}
 
-	return &authRepository{
-		validator: jwtValidator,
-	}, nil
+	issuer := &trustedIssuer{
+		name:            issuerU.String(),
+		provider:        provider,
+		claims:          cfg.Claims.withDefaults(),
+		principalPrefix: cfg.PrincipalPrefix,
+		validators:      make(map[string]*validator.Validator, len(algorithms)),
+	}
+	for _, alg := range algorithms {
+		if err := checkAlgorithm(alg); err != nil {
+			return nil, fmt.Errorf("issuer %s: %w", cfg.Issuer, err)
+		}
+
+		// Create JWT validator
+		jwtValidator, err := validator.New(
+			publicKeys(provider.KeyFunc, alg),
+			validator.SignatureAlgorithm(alg),
+			issuer.name,
+			cfg.Audiences,
+			validator.WithCustomClaims(customClaims),
+			validator.WithAllowedClockSkew(constants.JWTClockSkew),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create JWT validator for issuer %s (%s): %w", cfg.Issuer, alg, err)
+		}
+		issuer.validators[alg] = jwtValidator
+	}
+	return issuer, nil
 }
 
-// ValidateToken validates the provided token and returns the associated claims
+// ValidateToken validates the provided token with the issuer named by its
+// "iss" claim and returns the associated claims
 func (r *authRepository) ValidateToken(ctx context.Context, token string) (*contracts.HeimdallClaims, error) {
+	alg, iss, err := peekToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	issuer, ok := r.issuers[iss]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", constants.ErrUntrustedIssuer, iss)
+	}
+	jwtValidator, ok := issuer.validators[alg]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q from %q", constants.ErrAlgorithmNotAllowed, alg, iss)
+	}
+
 	// Validate the token
-	claims, err := r.validator.ValidateToken(ctx, token)
+	claims, err := jwtValidator.ValidateToken(ctx, token)
 	if err != nil {
 		return nil, err
 	}
@@ -90,41 +201,88 @@ func (r *authRepository) ValidateToken(ctx context.Context, token string) (*cont
 		return nil, fmt.Errorf("%w: unexpected validated claims type %T", constants.ErrUnexpectedResponse, claims)
 	}
 
-	customClaims, ok := validatedClaims.CustomClaims.(*contracts.HeimdallClaims)
+	customClaims, ok := validatedClaims.CustomClaims.(*tokenClaims)
 	if !ok {
 		return nil, fmt.Errorf("%w: unexpected custom claims type %T", constants.ErrUnexpectedResponse, validatedClaims.CustomClaims)
 	}
 
-	return customClaims, nil
+	heimdallClaims := issuer.claims.apply(*customClaims, validatedClaims.RegisteredClaims.Audience, issuer.principalPrefix)
+	for _, prefix := range issuer.reservedPrefixes {
+		if strings.HasPrefix(heimdallClaims.Principal, prefix) {
+			return nil, fmt.Errorf("%w: %q from %q uses the principal prefix of another issuer", constants.ErrInvalidPrincipal, heimdallClaims.Principal, iss)
+		}
+	}
+	if exp := validatedClaims.RegisteredClaims.Expiry; exp != 0 {
+		heimdallClaims.ExpiresAt = time.Unix(exp, 0)
+	}
+	if err := heimdallClaims.Validate(ctx); err != nil {
+		return nil, err
+	}
+	return heimdallClaims, nil
 }
 
-// HealthCheck verifies the auth service is accessible and JWKS can be fetched
-func (r *authRepository) HealthCheck(ctx context.Context) error {
-	if r.validator == nil {
-		return constants.ErrJWTValidatorNotInit
+// peekToken reads the signing algorithm and issuer of a compact JWS without
+// verifying it, to choose the validator that verifies it.
+func peekToken(token string) (alg, iss string, err error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", "", fmt.Errorf("%s: token is not a compact JWS", constants.ErrMsgJWTValidationFailed)
 	}
 
-	// Try to validate a minimal token structure to test JWKS connectivity
-	// This won't succeed as a real validation, but will test the JWKS endpoint
-	// If JWKS endpoint is down, this will fail appropriately
-	testToken := "eyJ0eXAiOiJKV1QiLCJhbGciOiJQUzI1NiJ9.eyJpc3MiOiJ0ZXN0IiwiYXVkIjoidGVzdCIsImV4cCI6OTk5OTk5OTk5OX0.test"
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return "", "", fmt.Errorf("%s: header: %w", constants.ErrMsgJWTValidationFailed, err)
+	}
 
-	// The validation will fail (expected) but if JWKS is unreachable,
-	// we'll get a network error instead of a validation error
-	_, err := r.validator.ValidateToken(ctx, testToken)
+	var claims struct {
+		Iss string `json:"iss"`
+	}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return "", "", fmt.Errorf("%s: claims: %w", constants.ErrMsgJWTValidationFailed, err)
+	}
+	return header.Alg, claims.Iss, nil
+}
 
-	// Check if error indicates JWKS connectivity issues
+// decodeSegment decodes one base64url-encoded JSON segment of a JWS.
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
 	if err != nil {
-		errStr := err.Error()
-		// Network/connectivity related errors indicate health issues
-		if strings.Contains(errStr, "connection refused") ||
-			strings.Contains(errStr, "timeout") ||
-			strings.Contains(errStr, "no such host") ||
-			strings.Contains(errStr, "network is unreachable") {
-			return fmt.Errorf("%s: %w", constants.ErrMsgJWKSEndpointNotAccessible, err)
-		}
-		// Other validation errors are expected for test token - JWKS is healthy
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// HealthCheck verifies that the JWKS of every trusted issuer can be fetched.
+// The error joins one error per unhealthy issuer.
+func (r *authRepository) HealthCheck(ctx context.Context) error {
+	if len(r.order) == 0 {
+		return constants.ErrJWTValidatorNotInit
 	}
 
+	errs := make([]error, len(r.order))
+	var wg sync.WaitGroup
+	for i, issuer := range r.order {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = issuer.healthCheck(ctx)
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// healthCheck fetches the issuer's JWKS, or uses the cached copy while it is
+// fresh, and records the outcome.
+func (i *trustedIssuer) healthCheck(ctx context.Context) error {
+	attrs := metric.WithAttributes(attribute.String("issuer", i.name))
+	if _, err := i.provider.KeyFunc(ctx); err != nil {
+		issuerHealthy.Record(ctx, 0, attrs)
+		slog.WarnContext(ctx, "Trusted issuer unhealthy", "issuer", i.name, "error", err)
+		return fmt.Errorf("issuer %s: %s: %w", i.name, constants.ErrMsgJWKSEndpointNotAccessible, err)
+	}
+	issuerHealthy.Record(ctx, 1, attrs)
 	return nil
 }
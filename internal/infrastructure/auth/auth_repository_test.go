@@ -28,8 +28,8 @@ func TestNewAuthRepository_Success(t *testing.T) {
 		t.Fatal("Expected *authRepository type")
 	}
 
-	if authRepo.validator == nil {
-		t.Error("Validator not initialized")
+	if len(authRepo.issuers) != 1 {
+		t.Errorf("Expected 1 trusted issuer, got %d", len(authRepo.issuers))
 	}
 }
 
@@ -290,7 +290,8 @@ func TestAuthRepository_Interface(t *testing.T) {
 		t.Fatal("Expected *authRepository type")
 	}
 
-	if authRepo.validator == nil {
+	trusted, ok := authRepo.issuers[issuer]
+	if !ok || len(trusted.validators) == 0 {
 		t.Error("Validator should not be nil")
 	}
 }
@@ -0,0 +1,161 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+// IssuerConfig describes one trusted token issuer. Tokens are matched to it
+// by their "iss" claim.
+type IssuerConfig struct {
+	// Issuer is the exact "iss" value of the issuer's tokens.
+	Issuer string `json:"issuer"`
+	// JWKSURL is where the issuer publishes its signing keys.
+	JWKSURL string `json:"jwks_url"`
+	// Audiences lists the accepted "aud" values; a token needs one of them.
+	Audiences []string `json:"audiences"`
+	// Algorithms lists the accepted signing algorithms, among RS*, PS*, ES*
+	// and EdDSA. It defaults to constants.DefaultJWTAlgorithm.
+	Algorithms []string `json:"algorithms,omitempty"`
+	// Claims names the token claims the caller identity is read from.
+	Claims ClaimMapping `json:"claims"`
+	// PrincipalPrefix is prepended to every principal read from the issuer's
+	// tokens, so that principals of different issuers never collide. When
+	// several issuers are trusted, at most one may leave it empty.
+	PrincipalPrefix string `json:"principal_prefix,omitempty"`
+}
+
+// ClaimMapping names the token claims the principal, email and client ID are
+// read from. Empty names keep the Heimdall claim names.
+type ClaimMapping struct {
+	Principal string `json:"principal,omitempty"`
+	Email     string `json:"email,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+}
+
+// withDefaults fills in the Heimdall claim names for unmapped claims.
+func (m ClaimMapping) withDefaults() ClaimMapping {
+	if m.Principal == "" {
+		m.Principal = constants.DefaultPrincipalClaim
+	}
+	if m.Email == "" {
+		m.Email = constants.DefaultEmailClaim
+	}
+	if m.ClientID == "" {
+		m.ClientID = constants.DefaultClientIDClaim
+	}
+	return m
+}
+
+// apply reads the caller identity out of the claims of a validated token and
+// prepends principalPrefix to the principal. Claims that are missing or not
+// strings are left empty.
+func (m ClaimMapping) apply(claims tokenClaims, audience []string, principalPrefix string) *contracts.HeimdallClaims {
+	principal := claims.string(m.Principal)
+	if principal != "" {
+		principal = principalPrefix + principal
+	}
+	return &contracts.HeimdallClaims{
+		Principal: principal,
+		Email:     claims.string(m.Email),
+		ClientID:  claims.string(m.ClientID),
+		Audience:  audience,
+	}
+}
+
+// ParseTrustedIssuers decodes a JSON list of issuers and checks that each
+// names a distinct issuer, a JWKS URL, at least one audience and only
+// supported algorithms, and that their principal prefixes keep principals
+// apart.
+func ParseTrustedIssuers(data []byte) ([]IssuerConfig, error) {
+	var issuers []IssuerConfig
+	if err := json.Unmarshal(data, &issuers); err != nil {
+		return nil, fmt.Errorf("%w: %w", constants.ErrInvalidTrustedIssuer, err)
+	}
+	if len(issuers) == 0 {
+		return nil, fmt.Errorf("%w: no issuers listed", constants.ErrInvalidTrustedIssuer)
+	}
+
+	seen := make(map[string]bool, len(issuers))
+	for i, issuer := range issuers {
+		if issuer.Issuer == "" {
+			return nil, fmt.Errorf("%w: issuer %d has no issuer", constants.ErrInvalidTrustedIssuer, i)
+		}
+		if seen[issuer.Issuer] {
+			return nil, fmt.Errorf("%w: issuer %q is listed twice", constants.ErrInvalidTrustedIssuer, issuer.Issuer)
+		}
+		seen[issuer.Issuer] = true
+
+		if u, err := url.Parse(issuer.JWKSURL); err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("%w: issuer %q needs an absolute jwks_url", constants.ErrInvalidTrustedIssuer, issuer.Issuer)
+		}
+		if len(issuer.Audiences) == 0 {
+			return nil, fmt.Errorf("%w: issuer %q has no audiences", constants.ErrInvalidTrustedIssuer, issuer.Issuer)
+		}
+		for _, alg := range issuer.Algorithms {
+			if err := checkAlgorithm(alg); err != nil {
+				return nil, fmt.Errorf("%w: issuer %q: %w", constants.ErrInvalidTrustedIssuer, issuer.Issuer, err)
+			}
+		}
+	}
+	if err := checkPrincipalPrefixes(issuers); err != nil {
+		return nil, err
+	}
+	return issuers, nil
+}
+
+// checkPrincipalPrefixes checks that no two issuers can map a token to the
+// same principal: at most one issuer has no prefix, and no prefix starts with
+// another. Principals of the unprefixed issuer that start with another
+// issuer's prefix are rejected when tokens are validated.
+func checkPrincipalPrefixes(issuers []IssuerConfig) error {
+	unprefixed := ""
+	for i, issuer := range issuers {
+		if domain.HasUnsafeRune(issuer.PrincipalPrefix) {
+			return fmt.Errorf("%w: issuer %q: principal_prefix contains whitespace or control characters", constants.ErrInvalidTrustedIssuer, issuer.Issuer)
+		}
+		if issuer.PrincipalPrefix == "" {
+			if unprefixed != "" {
+				return fmt.Errorf("%w: issuers %q and %q both lack a principal_prefix", constants.ErrInvalidTrustedIssuer, unprefixed, issuer.Issuer)
+			}
+			unprefixed = issuer.Issuer
+			continue
+		}
+		for _, other := range issuers[:i] {
+			if other.PrincipalPrefix == "" {
+				continue
+			}
+			if strings.HasPrefix(issuer.PrincipalPrefix, other.PrincipalPrefix) || strings.HasPrefix(other.PrincipalPrefix, issuer.PrincipalPrefix) {
+				return fmt.Errorf("%w: principal_prefix %q of issuer %q overlaps %q of issuer %q", constants.ErrInvalidTrustedIssuer,
+					issuer.PrincipalPrefix, issuer.Issuer, other.PrincipalPrefix, other.Issuer)
+			}
+		}
+	}
+	return nil
+}
+
+// tokenClaims holds every claim of a token so that ClaimMapping can pick the
+// ones an issuer uses. It implements validator.CustomClaims.
+type tokenClaims map[string]any
+
+// Validate implements validator.CustomClaims. Identity claims are checked
+// once mapped, by contracts.HeimdallClaims.Validate.
+func (c *tokenClaims) Validate(context.Context) error {
+	return nil
+}
+
+// string returns the named claim if it is a string.
+func (c tokenClaims) string(name string) string {
+	value, _ := c[name].(string)
+	return value
+}
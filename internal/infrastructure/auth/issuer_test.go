@@ -0,0 +1,265 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+	jose "gopkg.in/go-jose/go-jose.v2"
+	"gopkg.in/go-jose/go-jose.v2/jwt"
+)
+
+// testIssuer serves a JWKS for one locally generated key and signs tokens
+// with it.
+type testIssuer struct {
+	server *httptest.Server
+	key    any
+	alg    jose.SignatureAlgorithm
+}
+
+func newTestIssuer(t testing.TB, alg jose.SignatureAlgorithm, key any, public any) *testIssuer {
+	t.Helper()
+	jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: public, KeyID: "test-key", Algorithm: string(alg), Use: "sig"},
+	}})
+	if err != nil {
+		t.Fatalf("marshal JWKS: %v", err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(jwks)
+	}))
+	t.Cleanup(server.Close)
+	return &testIssuer{server: server, key: key, alg: alg}
+}
+
+func newRSATestIssuer(t testing.TB, alg jose.SignatureAlgorithm) *testIssuer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate RSA key: %v", err)
+	}
+	return newTestIssuer(t, alg, key, &key.PublicKey)
+}
+
+// config returns the trusted issuer configuration of the test issuer.
+func (i *testIssuer) config(audience string) IssuerConfig {
+	return IssuerConfig{
+		Issuer:     i.server.URL + "/",
+		JWKSURL:    i.server.URL + "/.well-known/jwks",
+		Audiences:  []string{audience},
+		Algorithms: []string{string(i.alg)},
+	}
+}
+
+// sign issues a token valid for an hour with the given claims.
+func (i *testIssuer) sign(t testing.TB, audience string, claims map[string]any) string {
+	t.Helper()
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: i.alg, Key: jose.JSONWebKey{Key: i.key, KeyID: "test-key"}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		t.Fatalf("create signer: %v", err)
+	}
+	now := time.Now()
+	token, err := jwt.Signed(signer).Claims(jwt.Claims{
+		Issuer:   i.server.URL + "/",
+		Audience: jwt.Audience{audience},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}).Claims(claims).CompactSerialize()
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return token
+}
+
+func TestParseTrustedIssuers(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "two issuers",
+			data: `[
+				{"issuer": "heimdall", "jwks_url": "http://heimdall:4457/.well-known/jwks", "audiences": ["lfx-v2-access-check"]},
+				{"issuer": "https://auth.example.com/", "jwks_url": "https://auth.example.com/.well-known/jwks.json",
+				 "audiences": ["access-check"], "algorithms": ["RS256"], "claims": {"principal": "sub"}, "principal_prefix": "partner|"}
+			]`,
+			want: 2,
+		},
+		{
+			name: "two issuers without principal prefix",
+			data: `[
+				{"issuer": "heimdall", "jwks_url": "http://heimdall/jwks", "audiences": ["a"]},
+				{"issuer": "https://auth.example.com/", "jwks_url": "https://auth.example.com/jwks", "audiences": ["b"]}
+			]`,
+			wantErr: true,
+		},
+		{
+			name: "overlapping principal prefixes",
+			data: `[
+				{"issuer": "heimdall", "jwks_url": "http://heimdall/jwks", "audiences": ["a"], "principal_prefix": "partner|"},
+				{"issuer": "https://auth.example.com/", "jwks_url": "https://auth.example.com/jwks", "audiences": ["b"], "principal_prefix": "partner|eu|"}
+			]`,
+			wantErr: true,
+		},
+		{name: "principal prefix with whitespace", data: `[{"issuer": "heimdall", "jwks_url": "http://heimdall/jwks", "audiences": ["a"], "principal_prefix": "a b|"}]`, wantErr: true},
+		{name: "not JSON", data: `issuer=heimdall`, wantErr: true},
+		{name: "empty list", data: `[]`, wantErr: true},
+		{name: "missing issuer", data: `[{"jwks_url": "http://heimdall/jwks", "audiences": ["a"]}]`, wantErr: true},
+		{name: "relative JWKS URL", data: `[{"issuer": "heimdall", "jwks_url": "/jwks", "audiences": ["a"]}]`, wantErr: true},
+		{name: "HMAC algorithm", data: `[{"issuer": "heimdall", "jwks_url": "http://heimdall/jwks", "audiences": ["a"], "algorithms": ["HS256"]}]`, wantErr: true},
+		{name: "no audiences", data: `[{"issuer": "heimdall", "jwks_url": "http://heimdall/jwks"}]`, wantErr: true},
+		{
+			name: "duplicate issuer",
+			data: `[
+				{"issuer": "heimdall", "jwks_url": "http://heimdall/jwks", "audiences": ["a"]},
+				{"issuer": "heimdall", "jwks_url": "http://other/jwks", "audiences": ["b"]}
+			]`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			issuers, err := ParseTrustedIssuers([]byte(tc.data))
+			if tc.wantErr {
+				if !errors.Is(err, constants.ErrInvalidTrustedIssuer) {
+					t.Fatalf("err = %v; want %v", err, constants.ErrInvalidTrustedIssuer)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTrustedIssuers failed: %v", err)
+			}
+			if len(issuers) != tc.want {
+				t.Errorf("got %d issuers; want %d", len(issuers), tc.want)
+			}
+		})
+	}
+}
+
+func TestTrustedIssuers_ValidateToken(t *testing.T) {
+	heimdall := newRSATestIssuer(t, jose.PS256)
+	partner := newRSATestIssuer(t, jose.PS256)
+	untrusted := newRSATestIssuer(t, jose.PS256)
+
+	partnerConfig := partner.config("partner-audience")
+	partnerConfig.Claims = ClaimMapping{Principal: "sub", Email: "mail"}
+	partnerConfig.PrincipalPrefix = "partner|"
+
+	repo, err := NewTrustedIssuersAuthRepository([]IssuerConfig{heimdall.config("lfx-v2-access-check"), partnerConfig})
+	if err != nil {
+		t.Fatalf("NewTrustedIssuersAuthRepository failed: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		token         string
+		wantPrincipal string
+		wantEmail     string
+		wantErr       error
+	}{
+		{
+			name:          "heimdall claims",
+			token:         heimdall.sign(t, "lfx-v2-access-check", map[string]any{"principal": "alice", "email": "alice@example.com"}),
+			wantPrincipal: "alice",
+			wantEmail:     "alice@example.com",
+		},
+		{
+			name:          "mapped claims",
+			token:         partner.sign(t, "partner-audience", map[string]any{"sub": "bob", "mail": "bob@example.com", "principal": "mallory"}),
+			wantPrincipal: "partner|bob",
+			wantEmail:     "bob@example.com",
+		},
+		{
+			// A partner principal equal to a Heimdall one stays apart.
+			name:          "partner principal namespaced",
+			token:         partner.sign(t, "partner-audience", map[string]any{"sub": "alice"}),
+			wantPrincipal: "partner|alice",
+		},
+		{
+			name:    "heimdall principal in the partner namespace",
+			token:   heimdall.sign(t, "lfx-v2-access-check", map[string]any{"principal": "partner|bob"}),
+			wantErr: constants.ErrInvalidPrincipal,
+		},
+		{
+			name:    "audience of another issuer",
+			token:   partner.sign(t, "lfx-v2-access-check", map[string]any{"sub": "bob"}),
+			wantErr: errors.New("aud"),
+		},
+		{
+			name:    "untrusted issuer",
+			token:   untrusted.sign(t, "lfx-v2-access-check", map[string]any{"principal": "eve"}),
+			wantErr: constants.ErrUntrustedIssuer,
+		},
+		{
+			name:    "mapped principal missing",
+			token:   partner.sign(t, "partner-audience", map[string]any{"principal": "mallory"}),
+			wantErr: constants.ErrPrincipalRequired,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			claims, err := repo.ValidateToken(context.Background(), tc.token)
+			if tc.wantErr != nil {
+				if err == nil {
+					t.Fatalf("ValidateToken succeeded; want error %v", tc.wantErr)
+				}
+				if !errors.Is(err, tc.wantErr) && !strings.Contains(err.Error(), tc.wantErr.Error()) {
+					t.Errorf("err = %v; want %v", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidateToken failed: %v", err)
+			}
+			if claims.Principal != tc.wantPrincipal || claims.Email != tc.wantEmail {
+				t.Errorf("claims = %+v; want principal %q email %q", claims, tc.wantPrincipal, tc.wantEmail)
+			}
+			if claims.ExpiresAt.IsZero() {
+				t.Error("ExpiresAt was not copied from the exp claim")
+			}
+		})
+	}
+}
+
+func TestTrustedIssuers_HealthCheck(t *testing.T) {
+	healthy := newRSATestIssuer(t, jose.PS256)
+	down := newRSATestIssuer(t, jose.PS256)
+	down.server.Close()
+
+	downConfig := down.config("b")
+	downConfig.PrincipalPrefix = "down|"
+	repo, err := NewTrustedIssuersAuthRepository([]IssuerConfig{healthy.config("a"), downConfig})
+	if err != nil {
+		t.Fatalf("NewTrustedIssuersAuthRepository failed: %v", err)
+	}
+
+	err = repo.HealthCheck(context.Background())
+	if err == nil {
+		t.Fatal("HealthCheck succeeded with an issuer down")
+	}
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok || len(joined.Unwrap()) != 1 {
+		t.Fatalf("HealthCheck error = %v; want one error per unhealthy issuer", err)
+	}
+	if !strings.Contains(err.Error(), down.server.URL) || strings.Contains(err.Error(), healthy.server.URL) {
+		t.Errorf("HealthCheck error = %v; want only %s reported", err, down.server.URL)
+	}
+}
@@ -7,9 +7,12 @@ package config
 import (
 	"flag"
 	"log/slog"
+	"math"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
 )
@@ -25,9 +28,136 @@ type Config struct {
 	JWKSUrl  string
 	Audience string
 	Issuer   string
+	// JWTAlgorithms lists the signing algorithms accepted from Issuer.
+	JWTAlgorithms []string
+	// TrustedIssuersPath points to a JSON list of trusted issuers. When set it
+	// replaces JWKSUrl, Audience and Issuer.
+	TrustedIssuersPath string
 
 	// NATS configuration
 	NATSUrl string
+
+	// Authorization configuration
+	// ListUsersRelation is the relation a caller must hold on an object to
+	// list the users of that object.
+	ListUsersRelation string
+	// PermissionModelPath points to a JSON permission model description. When
+	// empty the built-in default model is used.
+	PermissionModelPath string
+	// ContextualTupleClients lists the client IDs whose tokens may send
+	// contextual tuples and condition context. Empty refuses both.
+	ContextualTupleClients []string
+
+	// Decision cache configuration
+	// DecisionCacheSize caps the number of cached access-check decisions;
+	// zero disables the cache.
+	DecisionCacheSize int
+	// DecisionCacheTTL is how long a cached decision is served.
+	DecisionCacheTTL time.Duration
+	// GrantsCacheSize caps the number of cached my-grants pages; zero
+	// disables the cache.
+	GrantsCacheSize int
+	// GrantsCacheTTL is how long a cached my-grants page is served.
+	GrantsCacheTTL time.Duration
+	// TokenCacheSize caps the number of cached validated tokens; zero
+	// disables the cache.
+	TokenCacheSize int
+	// TokenCacheTTL caps how long a validated token is cached.
+	TokenCacheTTL time.Duration
+	// TupleChangeSubject is the fga-sync subject whose tuple change events
+	// evict cached decisions and grants. It is only subscribed to while one of
+	// those caches is enabled; empty disables invalidation.
+	TupleChangeSubject string
+
+	// Degraded mode configuration
+	// DegradedMaxStaleness is how old a cached decision may be and still be
+	// served while fga-sync is unavailable; zero disables degraded mode.
+	DegradedMaxStaleness time.Duration
+	// DegradedFailClosed denies checks with no cached decision in degraded
+	// mode instead of returning 503.
+	DegradedFailClosed bool
+
+	// Batching configuration
+	// CheckBatchWindow is how long checks of concurrent callers are collected
+	// into one access-check message; zero disables batching.
+	CheckBatchWindow time.Duration
+	// CheckBatchMaxSize caps the tuples in one batched message.
+	CheckBatchMaxSize int
+
+	// Chunking configuration
+	// CheckChunkSize caps the tuples per access-check message; larger calls
+	// are split into chunks.
+	CheckChunkSize int
+	// CheckChunkParallelism caps the chunks of one call in flight at once.
+	CheckChunkParallelism int
+
+	// Request timeout configuration
+	// RequestTimeoutMax bounds the deadline callers may set with
+	// X-Request-Timeout or grpc-timeout, and applies when they set none.
+	RequestTimeoutMax time.Duration
+
+	// Concurrency limit configuration
+	// ConcurrencyLimitMax is the starting and highest limit on HTTP requests
+	// served at once; zero disables the limiter.
+	ConcurrencyLimitMax int
+	// ConcurrencyLimitMin is the lowest the adaptive limit shrinks to.
+	ConcurrencyLimitMin int
+	// ConcurrencyTargetLatency is the request latency the limit adapts to.
+	ConcurrencyTargetLatency time.Duration
+	// ConcurrencyQueueTimeout is how long a request over the limit waits for
+	// a slot before it is shed with 429.
+	ConcurrencyQueueTimeout time.Duration
+	// ConcurrencyMaxQueue caps the requests waiting for a slot.
+	ConcurrencyMaxQueue int
+
+	// Rate limit configuration
+	// RateLimitRate is the average requests per second allowed per caller;
+	// zero disables rate limiting.
+	RateLimitRate float64
+	// RateLimitBurst is the number of requests a caller may make at once.
+	RateLimitBurst int
+	// RateLimitKey lists the token claims callers are told apart by.
+	RateLimitKey []string
+	// RateLimitOverrides sets per-principal limits as
+	// "principal=rate[:burst],...".
+	RateLimitOverrides string
+	// RateLimitStore is where rate-limit state is kept: in process, or in a
+	// NATS key-value bucket shared by all replicas.
+	RateLimitStore string
+	// RateLimitKVBucket names the NATS key-value bucket of the shared store.
+	RateLimitKVBucket string
+
+	// Retry configuration
+	// NATSRetryMaxAttempts is the total number of attempts for a read request
+	// that fails with a transient NATS error; one disables retries.
+	NATSRetryMaxAttempts int
+	// NATSRetryInitialBackoff is the base wait before the first retry.
+	NATSRetryInitialBackoff time.Duration
+	// NATSRetryMaxBackoff caps the base wait between retries.
+	NATSRetryMaxBackoff time.Duration
+	// NATSRetryMinAttemptTimeout is the least time an attempt is given when
+	// a request's deadline is split among its attempts.
+	NATSRetryMinAttemptTimeout time.Duration
+
+	// Hedging configuration
+	// NATSHedgePercentile is the percentile (1-99) of recent reply latencies
+	// after which a read request is sent a second time; zero disables hedging.
+	NATSHedgePercentile int
+	// NATSHedgeMinDelay is the shortest wait before hedging a request.
+	NATSHedgeMinDelay time.Duration
+	// NATSHedgeBudgetPercent caps hedges as a percentage of requests.
+	NATSHedgeBudgetPercent int
+
+	// Circuit breaker configuration
+	// CircuitBreakerFailureThreshold is the number of consecutive failed NATS
+	// requests that opens the circuit; zero disables the breaker.
+	CircuitBreakerFailureThreshold int
+	// CircuitBreakerOpenTimeout is how long the circuit stays open before a
+	// probe request is let through.
+	CircuitBreakerOpenTimeout time.Duration
+	// CircuitBreakerMinTimeout is the least time a request must have had to
+	// wait for its reply for a timeout to count as a failure.
+	CircuitBreakerMinTimeout time.Duration
 }
 
 // LoadConfig loads configuration from CLI flags, environment variables, and defaults
@@ -65,6 +195,60 @@ func LoadConfig() *Config {
 		Audience: getEnvOrDefault(constants.EnvAudience, constants.DefaultAudience),
 		Issuer:   getEnvOrDefault(constants.EnvIssuer, constants.DefaultIssuer),
 		NATSUrl:  getEnvOrDefault(constants.EnvNATSURL, constants.DefaultNATSURL),
+
+		JWTAlgorithms:      getJWTAlgorithms(),
+		TrustedIssuersPath: os.Getenv(constants.EnvTrustedIssuersPath),
+
+		ListUsersRelation:   getEnvOrDefault(constants.EnvListUsersRelation, constants.DefaultListUsersRelation),
+		PermissionModelPath: os.Getenv(constants.EnvPermissionModelPath),
+
+		ContextualTupleClients: splitList(os.Getenv(constants.EnvContextualTupleClients)),
+
+		DecisionCacheSize: getEnvIntOrDefault(constants.EnvDecisionCacheSize, constants.DefaultDecisionCacheSize),
+		DecisionCacheTTL:  getEnvDurationOrDefault(constants.EnvDecisionCacheTTL, constants.DefaultDecisionCacheTTL),
+		GrantsCacheSize:   getEnvIntOrDefault(constants.EnvGrantsCacheSize, constants.DefaultGrantsCacheSize),
+		GrantsCacheTTL:    getEnvDurationOrDefault(constants.EnvGrantsCacheTTL, constants.DefaultGrantsCacheTTL),
+		TokenCacheSize:    getEnvIntOrDefault(constants.EnvTokenCacheSize, constants.DefaultTokenCacheSize),
+		TokenCacheTTL:     getEnvDurationOrDefault(constants.EnvTokenCacheTTL, constants.DefaultTokenCacheTTL),
+
+		TupleChangeSubject: getEnvOrDefault(constants.EnvTupleChangeSubject, constants.DefaultTupleChangeSubject),
+
+		DegradedMaxStaleness: getEnvDurationOrDefault(constants.EnvDegradedMaxStaleness, constants.DefaultDegradedMaxStaleness),
+		DegradedFailClosed:   getDegradedMissPolicy() == constants.DegradedMissPolicyFailClosed,
+
+		CheckBatchWindow:  getEnvDurationOrDefault(constants.EnvCheckBatchWindow, constants.DefaultCheckBatchWindow),
+		CheckBatchMaxSize: getEnvIntOrDefault(constants.EnvCheckBatchMaxSize, constants.DefaultCheckBatchMaxSize),
+
+		CheckChunkSize:        getEnvIntOrDefault(constants.EnvCheckChunkSize, constants.DefaultCheckChunkSize),
+		CheckChunkParallelism: getEnvIntOrDefault(constants.EnvCheckChunkParallelism, constants.DefaultCheckChunkParallelism),
+
+		RequestTimeoutMax: getEnvDurationOrDefault(constants.EnvRequestTimeoutMax, constants.DefaultRequestTimeoutMax),
+
+		ConcurrencyLimitMax:      getEnvIntOrDefault(constants.EnvConcurrencyLimitMax, constants.DefaultConcurrencyLimitMax),
+		ConcurrencyLimitMin:      getEnvIntOrDefault(constants.EnvConcurrencyLimitMin, constants.DefaultConcurrencyLimitMin),
+		ConcurrencyTargetLatency: getEnvDurationOrDefault(constants.EnvConcurrencyTargetLatency, constants.DefaultConcurrencyTargetLatency),
+		ConcurrencyQueueTimeout:  getEnvDurationOrDefault(constants.EnvConcurrencyQueueTimeout, constants.DefaultConcurrencyQueueTimeout),
+		ConcurrencyMaxQueue:      getEnvIntOrDefault(constants.EnvConcurrencyMaxQueue, constants.DefaultConcurrencyMaxQueue),
+
+		RateLimitRate:      getEnvFloatOrDefault(constants.EnvRateLimitRate, constants.DefaultRateLimitRate),
+		RateLimitBurst:     getEnvIntOrDefault(constants.EnvRateLimitBurst, constants.DefaultRateLimitBurst),
+		RateLimitKey:       getRateLimitKey(),
+		RateLimitOverrides: os.Getenv(constants.EnvRateLimitOverrides),
+		RateLimitStore:     getRateLimitStore(),
+		RateLimitKVBucket:  getEnvOrDefault(constants.EnvRateLimitKVBucket, constants.DefaultRateLimitKVBucket),
+
+		NATSRetryMaxAttempts:       getEnvIntOrDefault(constants.EnvNATSRetryMaxAttempts, constants.DefaultNATSRetryMaxAttempts),
+		NATSRetryInitialBackoff:    getEnvDurationOrDefault(constants.EnvNATSRetryInitialBackoff, constants.DefaultNATSRetryInitialBackoff),
+		NATSRetryMaxBackoff:        getEnvDurationOrDefault(constants.EnvNATSRetryMaxBackoff, constants.DefaultNATSRetryMaxBackoff),
+		NATSRetryMinAttemptTimeout: getEnvDurationOrDefault(constants.EnvNATSRetryMinAttemptTimeout, constants.DefaultNATSRetryMinAttemptTimeout),
+
+		NATSHedgePercentile:    getHedgePercentile(),
+		NATSHedgeMinDelay:      getEnvDurationOrDefault(constants.EnvNATSHedgeMinDelay, constants.DefaultNATSHedgeMinDelay),
+		NATSHedgeBudgetPercent: getEnvIntOrDefault(constants.EnvNATSHedgeBudgetPercent, constants.DefaultNATSHedgeBudgetPercent),
+
+		CircuitBreakerFailureThreshold: getEnvIntOrDefault(constants.EnvCircuitBreakerFailureThreshold, constants.DefaultCircuitBreakerFailureThreshold),
+		CircuitBreakerOpenTimeout:      getEnvDurationOrDefault(constants.EnvCircuitBreakerOpenTimeout, constants.DefaultCircuitBreakerOpenTimeout),
+		CircuitBreakerMinTimeout:       getEnvDurationOrDefault(constants.EnvCircuitBreakerMinTimeout, constants.DefaultCircuitBreakerMinTimeout),
 	}
 
 	// Handle debug flag from environment (POC checks both DEBUG env and -d flag)
@@ -103,6 +287,126 @@ func getEnvOrDefault(envKey, defaultValue string) string {
 	return defaultValue
 }
 
+// getDegradedMissPolicy returns the degraded-mode miss policy, or the default
+// if it is unset or not a known policy
+func getDegradedMissPolicy() string {
+	policy := getEnvOrDefault(constants.EnvDegradedMissPolicy, constants.DefaultDegradedMissPolicy)
+	switch policy {
+	case constants.DegradedMissPolicyUnavailable, constants.DegradedMissPolicyFailClosed:
+		return policy
+	default:
+		slog.Warn("Ignoring invalid degraded miss policy", "env", constants.EnvDegradedMissPolicy, "value", policy, "default", constants.DefaultDegradedMissPolicy)
+		return constants.DefaultDegradedMissPolicy
+	}
+}
+
+// getHedgePercentile returns the hedge percentile, or the default if it is
+// unset or outside 0-99
+func getHedgePercentile() int {
+	percentile := getEnvIntOrDefault(constants.EnvNATSHedgePercentile, constants.DefaultNATSHedgePercentile)
+	if percentile > constants.MaxNATSHedgePercentile {
+		slog.Warn("Ignoring invalid hedge percentile", "env", constants.EnvNATSHedgePercentile, "value", percentile, "default", constants.DefaultNATSHedgePercentile)
+		return constants.DefaultNATSHedgePercentile
+	}
+	return percentile
+}
+
+// getRateLimitKey returns the claims callers are rate limited by, or the
+// default if it is unset or names an unknown claim
+func getRateLimitKey() []string {
+	value := getEnvOrDefault(constants.EnvRateLimitKey, constants.DefaultRateLimitKey)
+	var claims []string
+	for claim := range strings.SplitSeq(value, ",") {
+		claim = strings.TrimSpace(claim)
+		switch claim {
+		case constants.RateLimitKeyPrincipal, constants.RateLimitKeyClientID, constants.RateLimitKeyAudience:
+			if !slices.Contains(claims, claim) {
+				claims = append(claims, claim)
+			}
+		default:
+			slog.Warn("Ignoring invalid rate limit key", "env", constants.EnvRateLimitKey, "value", value, "default", constants.DefaultRateLimitKey)
+			return []string{constants.DefaultRateLimitKey}
+		}
+	}
+	return claims
+}
+
+// getJWTAlgorithms returns the comma-separated signing algorithms, or the
+// default if unset. Unsupported algorithms are rejected when the auth
+// repository is created, not dropped here.
+func getJWTAlgorithms() []string {
+	return splitList(getEnvOrDefault(constants.EnvJWTAlgorithms, constants.DefaultJWTAlgorithm))
+}
+
+// splitList returns the distinct non-empty entries of a comma-separated list
+func splitList(value string) []string {
+	var entries []string
+	for entry := range strings.SplitSeq(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" && !slices.Contains(entries, entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// getRateLimitStore returns the rate-limit store, or the default if it is
+// unset or not a known store
+func getRateLimitStore() string {
+	store := getEnvOrDefault(constants.EnvRateLimitStore, constants.DefaultRateLimitStore)
+	switch store {
+	case constants.RateLimitStoreMemory, constants.RateLimitStoreNATSKV:
+		return store
+	default:
+		slog.Warn("Ignoring invalid rate limit store", "env", constants.EnvRateLimitStore, "value", store, "default", constants.DefaultRateLimitStore)
+		return constants.DefaultRateLimitStore
+	}
+}
+
+// getEnvFloatOrDefault returns the environment variable parsed as a
+// non-negative number, or the default if it is unset or invalid
+func getEnvFloatOrDefault(envKey string, defaultValue float64) float64 {
+	value := os.Getenv(envKey)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || parsed < 0 || math.IsInf(parsed, 0) || math.IsNaN(parsed) {
+		slog.Warn("Ignoring invalid number environment variable", "env", envKey, "value", value, "default", defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
+
+// getEnvIntOrDefault returns the environment variable parsed as a non-negative
+// integer, or the default if it is unset or invalid
+func getEnvIntOrDefault(envKey string, defaultValue int) int {
+	value := os.Getenv(envKey)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || parsed < 0 {
+		slog.Warn("Ignoring invalid integer environment variable", "env", envKey, "value", value, "default", defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
+
+// getEnvDurationOrDefault returns the environment variable parsed as a
+// non-negative duration (e.g. "5s"), or the default if it is unset or invalid
+func getEnvDurationOrDefault(envKey string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(envKey)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil || parsed < 0 {
+		slog.Warn("Ignoring invalid duration environment variable", "env", envKey, "value", value, "default", defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
+
 // parseBool parses a string value to boolean with support for common boolean representations
 // Returns true for: "true", "1", "yes", "on", "y", "t" (case-insensitive)
 // Returns false for: "false", "0", "no", "off", "n", "f" (case-insensitive)
@@ -5,7 +5,9 @@ package config
 import (
 	"flag"
 	"os"
+	"slices"
 	"testing"
+	"time"
 )
 
 // saveFlags saves the current flag state
@@ -73,9 +75,123 @@ func TestLoadConfig_Defaults(t *testing.T) {
 	if config.Issuer != "heimdall" {
 		t.Errorf("Expected default Issuer to be 'heimdall', got '%s'", config.Issuer)
 	}
+	if !slices.Equal(config.JWTAlgorithms, []string{"PS256"}) {
+		t.Errorf("Expected default JWTAlgorithms to be [PS256], got %v", config.JWTAlgorithms)
+	}
+	if len(config.ContextualTupleClients) != 0 {
+		t.Errorf("Expected no default ContextualTupleClients, got %v", config.ContextualTupleClients)
+	}
 	if config.NATSUrl != "nats://nats:4222" {
 		t.Errorf("Expected default NATSUrl to be 'nats://nats:4222', got '%s'", config.NATSUrl)
 	}
+	if config.ListUsersRelation != "writer" {
+		t.Errorf("Expected default ListUsersRelation to be 'writer', got '%s'", config.ListUsersRelation)
+	}
+	if config.DecisionCacheSize != 0 {
+		t.Errorf("Expected default DecisionCacheSize to be 0, got %d", config.DecisionCacheSize)
+	}
+	if config.DecisionCacheTTL != 5*time.Second {
+		t.Errorf("Expected default DecisionCacheTTL to be 5s, got %v", config.DecisionCacheTTL)
+	}
+	if config.GrantsCacheSize != 0 {
+		t.Errorf("Expected default GrantsCacheSize to be 0, got %d", config.GrantsCacheSize)
+	}
+	if config.GrantsCacheTTL != 5*time.Second {
+		t.Errorf("Expected default GrantsCacheTTL to be 5s, got %v", config.GrantsCacheTTL)
+	}
+	if config.TokenCacheSize != 10000 {
+		t.Errorf("Expected default TokenCacheSize to be 10000, got %d", config.TokenCacheSize)
+	}
+	if config.TokenCacheTTL != 5*time.Minute {
+		t.Errorf("Expected default TokenCacheTTL to be 5m, got %v", config.TokenCacheTTL)
+	}
+	if config.TupleChangeSubject != "lfx.fga-sync.tuples_changed" {
+		t.Errorf("Expected default TupleChangeSubject to be 'lfx.fga-sync.tuples_changed', got '%s'", config.TupleChangeSubject)
+	}
+	if config.CheckBatchWindow != 0 {
+		t.Errorf("Expected batching to be disabled by default, got window %v", config.CheckBatchWindow)
+	}
+	if config.CheckBatchMaxSize != 100 {
+		t.Errorf("Expected default CheckBatchMaxSize to be 100, got %d", config.CheckBatchMaxSize)
+	}
+	if config.CheckChunkSize != 100 {
+		t.Errorf("Expected default CheckChunkSize to be 100, got %d", config.CheckChunkSize)
+	}
+	if config.CheckChunkParallelism != 4 {
+		t.Errorf("Expected default CheckChunkParallelism to be 4, got %d", config.CheckChunkParallelism)
+	}
+	if config.DegradedMaxStaleness != 0 {
+		t.Errorf("Expected default DegradedMaxStaleness to be 0, got %v", config.DegradedMaxStaleness)
+	}
+	if config.DegradedFailClosed {
+		t.Error("Expected DegradedFailClosed to default to false")
+	}
+	if config.RequestTimeoutMax != 15*time.Second {
+		t.Errorf("Expected default RequestTimeoutMax to be 15s, got %v", config.RequestTimeoutMax)
+	}
+	if config.ConcurrencyLimitMax != 0 {
+		t.Errorf("Expected default ConcurrencyLimitMax to be 0, got %d", config.ConcurrencyLimitMax)
+	}
+	if config.ConcurrencyLimitMin != 10 {
+		t.Errorf("Expected default ConcurrencyLimitMin to be 10, got %d", config.ConcurrencyLimitMin)
+	}
+	if config.ConcurrencyTargetLatency != time.Second {
+		t.Errorf("Expected default ConcurrencyTargetLatency to be 1s, got %v", config.ConcurrencyTargetLatency)
+	}
+	if config.ConcurrencyQueueTimeout != 100*time.Millisecond {
+		t.Errorf("Expected default ConcurrencyQueueTimeout to be 100ms, got %v", config.ConcurrencyQueueTimeout)
+	}
+	if config.ConcurrencyMaxQueue != 100 {
+		t.Errorf("Expected default ConcurrencyMaxQueue to be 100, got %d", config.ConcurrencyMaxQueue)
+	}
+	if config.RateLimitRate != 0 {
+		t.Errorf("Expected rate limiting to be disabled by default, got rate %v", config.RateLimitRate)
+	}
+	if config.RateLimitBurst != 20 {
+		t.Errorf("Expected default RateLimitBurst to be 20, got %d", config.RateLimitBurst)
+	}
+	if !slices.Equal(config.RateLimitKey, []string{"principal"}) {
+		t.Errorf("Expected default RateLimitKey to be [principal], got %v", config.RateLimitKey)
+	}
+	if config.RateLimitOverrides != "" {
+		t.Errorf("Expected no default RateLimitOverrides, got '%s'", config.RateLimitOverrides)
+	}
+	if config.RateLimitStore != "memory" {
+		t.Errorf("Expected default RateLimitStore to be 'memory', got '%s'", config.RateLimitStore)
+	}
+	if config.RateLimitKVBucket != "access-check-rate-limits" {
+		t.Errorf("Expected default RateLimitKVBucket to be 'access-check-rate-limits', got '%s'", config.RateLimitKVBucket)
+	}
+	if config.NATSRetryMaxAttempts != 3 {
+		t.Errorf("Expected default NATSRetryMaxAttempts to be 3, got %d", config.NATSRetryMaxAttempts)
+	}
+	if config.NATSRetryInitialBackoff != 50*time.Millisecond {
+		t.Errorf("Expected default NATSRetryInitialBackoff to be 50ms, got %v", config.NATSRetryInitialBackoff)
+	}
+	if config.NATSRetryMaxBackoff != time.Second {
+		t.Errorf("Expected default NATSRetryMaxBackoff to be 1s, got %v", config.NATSRetryMaxBackoff)
+	}
+	if config.NATSRetryMinAttemptTimeout != 15*time.Second {
+		t.Errorf("Expected default NATSRetryMinAttemptTimeout to be 15s, got %v", config.NATSRetryMinAttemptTimeout)
+	}
+	if config.NATSHedgePercentile != 0 {
+		t.Errorf("Expected default NATSHedgePercentile to be 0, got %d", config.NATSHedgePercentile)
+	}
+	if config.NATSHedgeMinDelay != 50*time.Millisecond {
+		t.Errorf("Expected default NATSHedgeMinDelay to be 50ms, got %v", config.NATSHedgeMinDelay)
+	}
+	if config.NATSHedgeBudgetPercent != 5 {
+		t.Errorf("Expected default NATSHedgeBudgetPercent to be 5, got %d", config.NATSHedgeBudgetPercent)
+	}
+	if config.CircuitBreakerFailureThreshold != 5 {
+		t.Errorf("Expected default CircuitBreakerFailureThreshold to be 5, got %d", config.CircuitBreakerFailureThreshold)
+	}
+	if config.CircuitBreakerOpenTimeout != 10*time.Second {
+		t.Errorf("Expected default CircuitBreakerOpenTimeout to be 10s, got %v", config.CircuitBreakerOpenTimeout)
+	}
+	if config.CircuitBreakerMinTimeout != time.Second {
+		t.Errorf("Expected default CircuitBreakerMinTimeout to be 1s, got %v", config.CircuitBreakerMinTimeout)
+	}
 }
 
 func TestLoadConfig_EnvironmentVariables(t *testing.T) {
@@ -98,6 +214,45 @@ func TestLoadConfig_EnvironmentVariables(t *testing.T) {
 	os.Setenv("AUDIENCE", "test-audience")
 	os.Setenv("ISSUER", "test-issuer")
 	os.Setenv("NATS_URL", "nats://test-nats:4222")
+	os.Setenv("LIST_USERS_RELATION", "auditor")
+	os.Setenv("CONTEXTUAL_TUPLE_CLIENTS", "what-if-ui, ,lfx-v2-ui,what-if-ui")
+	os.Setenv("JWT_ALGORITHMS", "RS256, ES256")
+	os.Setenv("TRUSTED_ISSUERS_PATH", "/etc/access-check/trusted-issuers.json")
+	os.Setenv("DECISION_CACHE_SIZE", "500")
+	os.Setenv("DECISION_CACHE_TTL", "1m")
+	os.Setenv("GRANTS_CACHE_SIZE", "50")
+	os.Setenv("GRANTS_CACHE_TTL", "30s")
+	os.Setenv("TOKEN_CACHE_SIZE", "200")
+	os.Setenv("TOKEN_CACHE_TTL", "2m")
+	os.Setenv("TUPLE_CHANGE_SUBJECT", "test.tuples_changed")
+	os.Setenv("CHECK_BATCH_WINDOW", "2ms")
+	os.Setenv("CHECK_BATCH_MAX_SIZE", "20")
+	os.Setenv("CHECK_CHUNK_SIZE", "25")
+	os.Setenv("CHECK_CHUNK_PARALLELISM", "8")
+	os.Setenv("DEGRADED_MAX_STALENESS", "10m")
+	os.Setenv("DEGRADED_MISS_POLICY", "fail-closed")
+	os.Setenv("REQUEST_TIMEOUT_MAX", "1m")
+	os.Setenv("CONCURRENCY_LIMIT_MAX", "200")
+	os.Setenv("CONCURRENCY_LIMIT_MIN", "20")
+	os.Setenv("CONCURRENCY_TARGET_LATENCY", "500ms")
+	os.Setenv("CONCURRENCY_QUEUE_TIMEOUT", "50ms")
+	os.Setenv("CONCURRENCY_MAX_QUEUE", "10")
+	os.Setenv("RATE_LIMIT_RATE", "2.5")
+	os.Setenv("RATE_LIMIT_BURST", "5")
+	os.Setenv("RATE_LIMIT_KEY", "principal,client_id")
+	os.Setenv("RATE_LIMIT_OVERRIDES", "bulk-importer=50:100")
+	os.Setenv("RATE_LIMIT_STORE", "nats-kv")
+	os.Setenv("RATE_LIMIT_KV_BUCKET", "test-rate-limits")
+	os.Setenv("NATS_RETRY_MAX_ATTEMPTS", "1")
+	os.Setenv("NATS_RETRY_INITIAL_BACKOFF", "10ms")
+	os.Setenv("NATS_RETRY_MAX_BACKOFF", "200ms")
+	os.Setenv("NATS_RETRY_MIN_ATTEMPT_TIMEOUT", "2s")
+	os.Setenv("NATS_HEDGE_PERCENTILE", "95")
+	os.Setenv("NATS_HEDGE_MIN_DELAY", "20ms")
+	os.Setenv("NATS_HEDGE_BUDGET_PERCENT", "10")
+	os.Setenv("CIRCUIT_BREAKER_FAILURE_THRESHOLD", "3")
+	os.Setenv("CIRCUIT_BREAKER_OPEN_TIMEOUT", "30s")
+	os.Setenv("CIRCUIT_BREAKER_MIN_TIMEOUT", "250ms")
 
 	config := LoadConfig()
 
@@ -120,9 +275,274 @@ func TestLoadConfig_EnvironmentVariables(t *testing.T) {
 	if config.Issuer != "test-issuer" {
 		t.Errorf("Expected Issuer from env to be 'test-issuer', got '%s'", config.Issuer)
 	}
+	if !slices.Equal(config.JWTAlgorithms, []string{"RS256", "ES256"}) {
+		t.Errorf("Expected JWTAlgorithms from env to be [RS256 ES256], got %v", config.JWTAlgorithms)
+	}
+	if !slices.Equal(config.ContextualTupleClients, []string{"what-if-ui", "lfx-v2-ui"}) {
+		t.Errorf("Expected ContextualTupleClients from env to be [what-if-ui lfx-v2-ui], got %v", config.ContextualTupleClients)
+	}
+	if config.TrustedIssuersPath != "/etc/access-check/trusted-issuers.json" {
+		t.Errorf("Expected TrustedIssuersPath from env, got '%s'", config.TrustedIssuersPath)
+	}
 	if config.NATSUrl != "nats://test-nats:4222" {
 		t.Errorf("Expected NATSUrl from env to be 'nats://test-nats:4222', got '%s'", config.NATSUrl)
 	}
+	if config.ListUsersRelation != "auditor" {
+		t.Errorf("Expected ListUsersRelation from env to be 'auditor', got '%s'", config.ListUsersRelation)
+	}
+	if config.DecisionCacheSize != 500 {
+		t.Errorf("Expected DecisionCacheSize from env to be 500, got %d", config.DecisionCacheSize)
+	}
+	if config.DecisionCacheTTL != time.Minute {
+		t.Errorf("Expected DecisionCacheTTL from env to be 1m, got %v", config.DecisionCacheTTL)
+	}
+	if config.GrantsCacheSize != 50 {
+		t.Errorf("Expected GrantsCacheSize from env to be 50, got %d", config.GrantsCacheSize)
+	}
+	if config.GrantsCacheTTL != 30*time.Second {
+		t.Errorf("Expected GrantsCacheTTL from env to be 30s, got %v", config.GrantsCacheTTL)
+	}
+	if config.TokenCacheSize != 200 {
+		t.Errorf("Expected TokenCacheSize from env to be 200, got %d", config.TokenCacheSize)
+	}
+	if config.TokenCacheTTL != 2*time.Minute {
+		t.Errorf("Expected TokenCacheTTL from env to be 2m, got %v", config.TokenCacheTTL)
+	}
+	if config.TupleChangeSubject != "test.tuples_changed" {
+		t.Errorf("Expected TupleChangeSubject from env to be 'test.tuples_changed', got '%s'", config.TupleChangeSubject)
+	}
+	if config.CheckBatchWindow != 2*time.Millisecond {
+		t.Errorf("Expected CheckBatchWindow from env to be 2ms, got %v", config.CheckBatchWindow)
+	}
+	if config.CheckBatchMaxSize != 20 {
+		t.Errorf("Expected CheckBatchMaxSize from env to be 20, got %d", config.CheckBatchMaxSize)
+	}
+	if config.CheckChunkSize != 25 {
+		t.Errorf("Expected CheckChunkSize from env to be 25, got %d", config.CheckChunkSize)
+	}
+	if config.CheckChunkParallelism != 8 {
+		t.Errorf("Expected CheckChunkParallelism from env to be 8, got %d", config.CheckChunkParallelism)
+	}
+	if config.DegradedMaxStaleness != 10*time.Minute {
+		t.Errorf("Expected DegradedMaxStaleness from env to be 10m, got %v", config.DegradedMaxStaleness)
+	}
+	if !config.DegradedFailClosed {
+		t.Error("Expected DegradedFailClosed from env to be true")
+	}
+	if config.RequestTimeoutMax != time.Minute {
+		t.Errorf("Expected RequestTimeoutMax from env to be 1m, got %v", config.RequestTimeoutMax)
+	}
+	if config.ConcurrencyLimitMax != 200 {
+		t.Errorf("Expected ConcurrencyLimitMax from env to be 200, got %d", config.ConcurrencyLimitMax)
+	}
+	if config.ConcurrencyLimitMin != 20 {
+		t.Errorf("Expected ConcurrencyLimitMin from env to be 20, got %d", config.ConcurrencyLimitMin)
+	}
+	if config.ConcurrencyTargetLatency != 500*time.Millisecond {
+		t.Errorf("Expected ConcurrencyTargetLatency from env to be 500ms, got %v", config.ConcurrencyTargetLatency)
+	}
+	if config.ConcurrencyQueueTimeout != 50*time.Millisecond {
+		t.Errorf("Expected ConcurrencyQueueTimeout from env to be 50ms, got %v", config.ConcurrencyQueueTimeout)
+	}
+	if config.ConcurrencyMaxQueue != 10 {
+		t.Errorf("Expected ConcurrencyMaxQueue from env to be 10, got %d", config.ConcurrencyMaxQueue)
+	}
+	if config.RateLimitRate != 2.5 {
+		t.Errorf("Expected RateLimitRate from env to be 2.5, got %v", config.RateLimitRate)
+	}
+	if config.RateLimitBurst != 5 {
+		t.Errorf("Expected RateLimitBurst from env to be 5, got %d", config.RateLimitBurst)
+	}
+	if !slices.Equal(config.RateLimitKey, []string{"principal", "client_id"}) {
+		t.Errorf("Expected RateLimitKey from env to be [principal client_id], got %v", config.RateLimitKey)
+	}
+	if config.RateLimitOverrides != "bulk-importer=50:100" {
+		t.Errorf("Expected RateLimitOverrides from env, got '%s'", config.RateLimitOverrides)
+	}
+	if config.RateLimitStore != "nats-kv" {
+		t.Errorf("Expected RateLimitStore from env to be 'nats-kv', got '%s'", config.RateLimitStore)
+	}
+	if config.RateLimitKVBucket != "test-rate-limits" {
+		t.Errorf("Expected RateLimitKVBucket from env to be 'test-rate-limits', got '%s'", config.RateLimitKVBucket)
+	}
+	if config.NATSRetryMaxAttempts != 1 {
+		t.Errorf("Expected NATSRetryMaxAttempts from env to be 1, got %d", config.NATSRetryMaxAttempts)
+	}
+	if config.NATSRetryInitialBackoff != 10*time.Millisecond {
+		t.Errorf("Expected NATSRetryInitialBackoff from env to be 10ms, got %v", config.NATSRetryInitialBackoff)
+	}
+	if config.NATSRetryMaxBackoff != 200*time.Millisecond {
+		t.Errorf("Expected NATSRetryMaxBackoff from env to be 200ms, got %v", config.NATSRetryMaxBackoff)
+	}
+	if config.NATSRetryMinAttemptTimeout != 2*time.Second {
+		t.Errorf("Expected NATSRetryMinAttemptTimeout from env to be 2s, got %v", config.NATSRetryMinAttemptTimeout)
+	}
+	if config.NATSHedgePercentile != 95 {
+		t.Errorf("Expected NATSHedgePercentile from env to be 95, got %d", config.NATSHedgePercentile)
+	}
+	if config.NATSHedgeMinDelay != 20*time.Millisecond {
+		t.Errorf("Expected NATSHedgeMinDelay from env to be 20ms, got %v", config.NATSHedgeMinDelay)
+	}
+	if config.NATSHedgeBudgetPercent != 10 {
+		t.Errorf("Expected NATSHedgeBudgetPercent from env to be 10, got %d", config.NATSHedgeBudgetPercent)
+	}
+	if config.CircuitBreakerFailureThreshold != 3 {
+		t.Errorf("Expected CircuitBreakerFailureThreshold from env to be 3, got %d", config.CircuitBreakerFailureThreshold)
+	}
+	if config.CircuitBreakerOpenTimeout != 30*time.Second {
+		t.Errorf("Expected CircuitBreakerOpenTimeout from env to be 30s, got %v", config.CircuitBreakerOpenTimeout)
+	}
+	if config.CircuitBreakerMinTimeout != 250*time.Millisecond {
+		t.Errorf("Expected CircuitBreakerMinTimeout from env to be 250ms, got %v", config.CircuitBreakerMinTimeout)
+	}
+}
+
+func TestLoadConfig_DecisionCacheEnvironmentVariables(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     string
+		ttl      string
+		wantSize int
+		wantTTL  time.Duration
+	}{
+		{"disabled", "0", "0s", 0, 0},
+		{"custom", "42", "250ms", 42, 250 * time.Millisecond},
+		{"invalid_size", "lots", "", 0, 5 * time.Second},
+		{"negative_size", "-1", "", 0, 5 * time.Second},
+		{"invalid_ttl", "10000", "soon", 10000, 5 * time.Second},
+		{"negative_ttl", "10000", "-1s", 10000, 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalFlags := saveFlags()
+			defer restoreFlags(originalFlags)
+
+			clearEnvVars()
+			defer clearEnvVars()
+
+			flag.CommandLine = flag.NewFlagSet("test", flag.ContinueOnError)
+
+			os.Setenv("DECISION_CACHE_SIZE", tt.size)
+			os.Setenv("DECISION_CACHE_TTL", tt.ttl)
+
+			config := LoadConfig()
+			if config.DecisionCacheSize != tt.wantSize {
+				t.Errorf("Expected DecisionCacheSize %d, got %d", tt.wantSize, config.DecisionCacheSize)
+			}
+			if config.DecisionCacheTTL != tt.wantTTL {
+				t.Errorf("Expected DecisionCacheTTL %v, got %v", tt.wantTTL, config.DecisionCacheTTL)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_DegradedMissPolicy(t *testing.T) {
+	tests := []struct {
+		name           string
+		policy         string
+		wantFailClosed bool
+	}{
+		{"unset", "", false},
+		{"unavailable", "unavailable", false},
+		{"fail_closed", "fail-closed", true},
+		{"invalid", "fail-open", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalFlags := saveFlags()
+			defer restoreFlags(originalFlags)
+
+			clearEnvVars()
+			defer clearEnvVars()
+
+			flag.CommandLine = flag.NewFlagSet("test", flag.ContinueOnError)
+
+			os.Setenv("DEGRADED_MISS_POLICY", tt.policy)
+
+			config := LoadConfig()
+			if config.DegradedFailClosed != tt.wantFailClosed {
+				t.Errorf("Expected DegradedFailClosed %v, got %v", tt.wantFailClosed, config.DegradedFailClosed)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_HedgePercentile(t *testing.T) {
+	tests := []struct {
+		name       string
+		percentile string
+		want       int
+	}{
+		{"unset", "", 0},
+		{"in range", "95", 95},
+		{"highest", "99", 99},
+		{"out of range", "100", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalFlags := saveFlags()
+			defer restoreFlags(originalFlags)
+
+			clearEnvVars()
+			defer clearEnvVars()
+
+			flag.CommandLine = flag.NewFlagSet("test", flag.ContinueOnError)
+
+			os.Setenv("NATS_HEDGE_PERCENTILE", tt.percentile)
+
+			config := LoadConfig()
+			if config.NATSHedgePercentile != tt.want {
+				t.Errorf("Expected NATSHedgePercentile %d, got %d", tt.want, config.NATSHedgePercentile)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_RateLimitKeyAndStore(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		store     string
+		rate      string
+		wantKey   []string
+		wantStore string
+		wantRate  float64
+	}{
+		{"unset", "", "", "", []string{"principal"}, "memory", 0},
+		{"composite key", "client_id, audience", "memory", "0.5", []string{"client_id", "audience"}, "memory", 0.5},
+		{"duplicate claims", "principal,principal", "nats-kv", "10", []string{"principal"}, "nats-kv", 10},
+		{"unknown claim", "principal,email", "redis", "-1", []string{"principal"}, "memory", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalFlags := saveFlags()
+			defer restoreFlags(originalFlags)
+
+			clearEnvVars()
+			defer clearEnvVars()
+
+			flag.CommandLine = flag.NewFlagSet("test", flag.ContinueOnError)
+
+			os.Setenv("RATE_LIMIT_KEY", tt.key)
+			os.Setenv("RATE_LIMIT_STORE", tt.store)
+			os.Setenv("RATE_LIMIT_RATE", tt.rate)
+
+			config := LoadConfig()
+			if !slices.Equal(config.RateLimitKey, tt.wantKey) {
+				t.Errorf("Expected RateLimitKey %v, got %v", tt.wantKey, config.RateLimitKey)
+			}
+			if config.RateLimitStore != tt.wantStore {
+				t.Errorf("Expected RateLimitStore '%s', got '%s'", tt.wantStore, config.RateLimitStore)
+			}
+			if config.RateLimitRate != tt.wantRate {
+				t.Errorf("Expected RateLimitRate %v, got %v", tt.wantRate, config.RateLimitRate)
+			}
+		})
+	}
 }
 
 func TestLoadConfig_PartialEnvironmentVariables(t *testing.T) {
@@ -313,7 +733,46 @@ func clearEnvVars() {
 	os.Unsetenv("JWKS_URL")
 	os.Unsetenv("AUDIENCE")
 	os.Unsetenv("ISSUER")
+	os.Unsetenv("JWT_ALGORITHMS")
+	os.Unsetenv("TRUSTED_ISSUERS_PATH")
 	os.Unsetenv("NATS_URL")
+	os.Unsetenv("LIST_USERS_RELATION")
+	os.Unsetenv("CONTEXTUAL_TUPLE_CLIENTS")
+	os.Unsetenv("DECISION_CACHE_SIZE")
+	os.Unsetenv("DECISION_CACHE_TTL")
+	os.Unsetenv("GRANTS_CACHE_SIZE")
+	os.Unsetenv("GRANTS_CACHE_TTL")
+	os.Unsetenv("TOKEN_CACHE_SIZE")
+	os.Unsetenv("TOKEN_CACHE_TTL")
+	os.Unsetenv("TUPLE_CHANGE_SUBJECT")
+	os.Unsetenv("CHECK_BATCH_WINDOW")
+	os.Unsetenv("CHECK_BATCH_MAX_SIZE")
+	os.Unsetenv("CHECK_CHUNK_SIZE")
+	os.Unsetenv("CHECK_CHUNK_PARALLELISM")
+	os.Unsetenv("DEGRADED_MAX_STALENESS")
+	os.Unsetenv("DEGRADED_MISS_POLICY")
+	os.Unsetenv("REQUEST_TIMEOUT_MAX")
+	os.Unsetenv("CONCURRENCY_LIMIT_MAX")
+	os.Unsetenv("CONCURRENCY_LIMIT_MIN")
+	os.Unsetenv("CONCURRENCY_TARGET_LATENCY")
+	os.Unsetenv("CONCURRENCY_QUEUE_TIMEOUT")
+	os.Unsetenv("CONCURRENCY_MAX_QUEUE")
+	os.Unsetenv("RATE_LIMIT_RATE")
+	os.Unsetenv("RATE_LIMIT_BURST")
+	os.Unsetenv("RATE_LIMIT_KEY")
+	os.Unsetenv("RATE_LIMIT_OVERRIDES")
+	os.Unsetenv("RATE_LIMIT_STORE")
+	os.Unsetenv("RATE_LIMIT_KV_BUCKET")
+	os.Unsetenv("NATS_RETRY_MAX_ATTEMPTS")
+	os.Unsetenv("NATS_RETRY_INITIAL_BACKOFF")
+	os.Unsetenv("NATS_RETRY_MAX_BACKOFF")
+	os.Unsetenv("NATS_RETRY_MIN_ATTEMPT_TIMEOUT")
+	os.Unsetenv("NATS_HEDGE_PERCENTILE")
+	os.Unsetenv("NATS_HEDGE_MIN_DELAY")
+	os.Unsetenv("NATS_HEDGE_BUDGET_PERCENT")
+	os.Unsetenv("CIRCUIT_BREAKER_FAILURE_THRESHOLD")
+	os.Unsetenv("CIRCUIT_BREAKER_OPEN_TIMEOUT")
+	os.Unsetenv("CIRCUIT_BREAKER_MIN_TIMEOUT")
 }
 
 func TestParseBool(t *testing.T) {
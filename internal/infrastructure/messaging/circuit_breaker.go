@@ -0,0 +1,269 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// circuitState is the state of a circuitBreakerRepository. The numeric values
+// are what the state gauge reports.
+type circuitState int64
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitClosed:
+		return "closed"
+	case circuitOpen:
+		return "open"
+	case circuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+var circuitStateKey = attribute.Key("circuit_breaker.state")
+
+var (
+	// circuitStateGauge reports the current state: 0 closed, 1 open, 2 half-open.
+	circuitStateGauge, _ = meter.Int64Gauge("access_check.circuit_breaker.state",
+		metric.WithDescription("Messaging circuit breaker state (0 closed, 1 open, 2 half-open)"),
+	)
+	circuitTransitions, _ = meter.Int64Counter("access_check.circuit_breaker.transitions",
+		metric.WithDescription("Messaging circuit breaker state changes, by new state"),
+		metric.WithUnit("{transition}"),
+	)
+	circuitRejections, _ = meter.Int64Counter("access_check.circuit_breaker.rejected_requests",
+		metric.WithDescription("NATS requests refused without being sent because the circuit was open"),
+		metric.WithUnit("{request}"),
+	)
+)
+
+// circuitOpenError is returned for requests refused by an open circuit. It
+// matches constants.ErrCircuitOpen and implements contracts.RetryAfterError.
+type circuitOpenError struct {
+	retryAfter time.Duration
+}
+
+func (e *circuitOpenError) Error() string {
+	return fmt.Sprintf("%s: retry after %s", constants.ErrMsgCircuitOpen, e.retryAfter)
+}
+
+func (e *circuitOpenError) Unwrap() error { return constants.ErrCircuitOpen }
+
+// RetryAfter returns how long until the circuit lets a probe request through.
+func (e *circuitOpenError) RetryAfter() time.Duration { return e.retryAfter }
+
+var _ contracts.RetryAfterError = (*circuitOpenError)(nil)
+
+// circuitBreakerRepository stops sending NATS requests after a run of
+// consecutive failures, so a dead or overloaded backend fails fast instead of
+// holding every caller for the full request timeout.
+//
+// After failureThreshold consecutive failures the circuit opens and requests
+// are refused with a circuitOpenError. Once openTimeout has passed a single
+// probe request is let through (half-open): success closes the circuit,
+// failure opens it for another openTimeout.
+//
+// A timeout only counts as a failure when the request had at least
+// minFailureTimeout to wait for its reply; shorter budgets are the caller's
+// choice and say nothing about the backend.
+type circuitBreakerRepository struct {
+	contracts.MessagingRepository
+
+	failureThreshold  int
+	openTimeout       time.Duration
+	minFailureTimeout time.Duration
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+
+	// now is the clock used for the open timeout; tests replace it.
+	now func() time.Time
+}
+
+// NewCircuitBreakerRepository wraps next with a circuit breaker that opens
+// after failureThreshold consecutive failed requests and probes again after
+// openTimeout. Timeouts of requests given less than minFailureTimeout are not
+// counted. Subscribe and Close go straight to next.
+func NewCircuitBreakerRepository(next contracts.MessagingRepository, failureThreshold int, openTimeout, minFailureTimeout time.Duration) contracts.MessagingRepository {
+	circuitStateGauge.Record(context.Background(), int64(circuitClosed))
+	return &circuitBreakerRepository{
+		MessagingRepository: next,
+		failureThreshold:    failureThreshold,
+		openTimeout:         openTimeout,
+		minFailureTimeout:   minFailureTimeout,
+		now:                 time.Now,
+	}
+}
+
+// Request sends the request through next unless the circuit is open.
+//
+// Errors caused by the caller's own context being cancelled or expiring say
+// nothing about the backend and are not counted as failures. The NATS timeout
+// is clamped to the caller's deadline, so a timeout is only counted when the
+// request had at least minFailureTimeout to wait: otherwise one client sending
+// very short deadlines could open the circuit for every other caller.
+func (r *circuitBreakerRepository) Request(ctx context.Context, subject string, data []byte, timeout time.Duration) ([]byte, error) {
+	probe, err := r.allow(ctx)
+	if err != nil {
+		circuitRejections.Add(ctx, 1, metric.WithAttributes(semconv.MessagingDestinationName(subject)))
+		trace.SpanFromContext(ctx).AddEvent("nats.request.circuit_open",
+			trace.WithAttributes(semconv.MessagingDestinationName(subject)))
+		return nil, err
+	}
+
+	budget := timeout
+	if deadline, ok := ctx.Deadline(); ok {
+		budget = min(budget, time.Until(deadline))
+	}
+
+	reply, err := r.MessagingRepository.Request(ctx, subject, data, timeout)
+	r.record(ctx, probe, err == nil, r.abandoned(ctx, err, budget))
+	return reply, err
+}
+
+// abandoned reports whether a failed request should be left out of the
+// failure count: either the caller gave up on it, or it timed out within a
+// budget shorter than minFailureTimeout.
+func (r *circuitBreakerRepository) abandoned(ctx context.Context, err error, budget time.Duration) bool {
+	switch {
+	case err == nil:
+		return false
+	case isRequestTimeout(err):
+		return budget < r.minFailureTimeout
+	default:
+		return ctx.Err() != nil
+	}
+}
+
+// isRequestTimeout reports whether err is the backend failing to reply within
+// the request timeout.
+func isRequestTimeout(err error) bool {
+	return errors.Is(err, constants.ErrRequestTimeout) || errors.Is(err, nats.ErrTimeout)
+}
+
+// HealthCheck reports the health of next. When next is healthy but the
+// circuit is open it returns a circuitOpenError, which readiness reports
+// without failing: a backend outage opens the circuit on every replica at
+// once, and taking them all out of rotation would stop degraded mode from
+// serving anything.
+func (r *circuitBreakerRepository) HealthCheck(ctx context.Context) error {
+	if err := r.MessagingRepository.HealthCheck(ctx); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.state == circuitOpen {
+		if remaining := r.openTimeout - r.now().Sub(r.openedAt); remaining > 0 {
+			return &circuitOpenError{retryAfter: remaining}
+		}
+	}
+	return nil
+}
+
+// allow decides whether a request may be sent and whether it is the
+// half-open probe.
+func (r *circuitBreakerRepository) allow(ctx context.Context) (probe bool, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch r.state {
+	case circuitOpen:
+		remaining := r.openTimeout - r.now().Sub(r.openedAt)
+		if remaining > 0 {
+			return false, &circuitOpenError{retryAfter: remaining}
+		}
+		r.transitionLocked(ctx, circuitHalfOpen)
+		r.probing = true
+		return true, nil
+	case circuitHalfOpen:
+		if r.probing {
+			// The outcome of the probe in flight decides the next state; until
+			// then callers are told to come back after a full open period.
+			return false, &circuitOpenError{retryAfter: r.openTimeout}
+		}
+		r.probing = true
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// record updates the circuit with the outcome of a request. Requests
+// abandoned by their caller only release the probe slot.
+func (r *circuitBreakerRepository) record(ctx context.Context, probe, success, abandoned bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if probe {
+		r.probing = false
+	}
+
+	switch {
+	case abandoned:
+		return
+	case success:
+		r.failures = 0
+		if r.state == circuitHalfOpen {
+			r.transitionLocked(ctx, circuitClosed)
+		}
+	case r.state == circuitHalfOpen:
+		if probe {
+			r.openLocked(ctx)
+		}
+	case r.state == circuitClosed:
+		r.failures++
+		if r.failures >= r.failureThreshold {
+			r.openLocked(ctx)
+		}
+	}
+}
+
+func (r *circuitBreakerRepository) openLocked(ctx context.Context) {
+	r.openedAt = r.now()
+	r.failures = 0
+	r.transitionLocked(ctx, circuitOpen)
+}
+
+func (r *circuitBreakerRepository) transitionLocked(ctx context.Context, to circuitState) {
+	from := r.state
+	r.state = to
+
+	attrs := metric.WithAttributes(circuitStateKey.String(to.String()))
+	circuitStateGauge.Record(ctx, int64(to))
+	circuitTransitions.Add(ctx, 1, attrs)
+
+	if to == circuitOpen {
+		slog.WarnContext(ctx, "messaging circuit breaker opened",
+			"from", from.String(), "open_timeout", r.openTimeout)
+		return
+	}
+	slog.InfoContext(ctx, "messaging circuit breaker state changed",
+		"from", from.String(), "to", to.String())
+}
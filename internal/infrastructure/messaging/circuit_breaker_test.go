@@ -0,0 +1,254 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+	"github.com/nats-io/nats.go"
+)
+
+var errBackend = errors.New("nats: no responders available for request")
+
+// newTestCircuitBreaker returns a breaker around a stub whose outcome is read
+// from *fail, along with a pointer to the breaker's clock and a call counter.
+func newTestCircuitBreaker(threshold int, fail *bool) (*circuitBreakerRepository, *time.Time, *int) {
+	calls := 0
+	stub := &stubRepository{requestFunc: func(ctx context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+		calls++
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if *fail {
+			return nil, errBackend
+		}
+		return []byte("ok"), nil
+	}}
+
+	now := time.Unix(1700000000, 0)
+	cb := NewCircuitBreakerRepository(stub, threshold, 10*time.Second, time.Second).(*circuitBreakerRepository)
+	cb.now = func() time.Time { return now }
+	return cb, &now, &calls
+}
+
+func TestCircuitBreaker_OpensAfterThreshold(t *testing.T) {
+	fail := true
+	cb, _, calls := newTestCircuitBreaker(3, &fail)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := cb.Request(ctx, "subj", nil, time.Second); !errors.Is(err, errBackend) {
+			t.Fatalf("request %d: err = %v; want backend error", i, err)
+		}
+	}
+
+	_, err := cb.Request(ctx, "subj", nil, time.Second)
+	if !errors.Is(err, constants.ErrCircuitOpen) {
+		t.Fatalf("err = %v; want ErrCircuitOpen", err)
+	}
+	var retryErr contracts.RetryAfterError
+	if !errors.As(err, &retryErr) || retryErr.RetryAfter() != 10*time.Second {
+		t.Errorf("expected RetryAfterError with 10s, got %v", err)
+	}
+	if *calls != 3 {
+		t.Errorf("backend called %d times; want 3", *calls)
+	}
+	if err := cb.HealthCheck(ctx); !errors.Is(err, constants.ErrCircuitOpen) {
+		t.Errorf("HealthCheck() = %v; want ErrCircuitOpen", err)
+	}
+}
+
+func TestCircuitBreaker_HealthCheckReportsLostConnection(t *testing.T) {
+	fail := true
+	cb, _, _ := newTestCircuitBreaker(1, &fail)
+	ctx := context.Background()
+
+	_, _ = cb.Request(ctx, "subj", nil, time.Second)
+	cb.MessagingRepository.(*stubRepository).healthErr = constants.ErrNATSConnClosed
+
+	// Readiness tolerates an open circuit but not a lost connection, so the
+	// connection error wins.
+	if err := cb.HealthCheck(ctx); !errors.Is(err, constants.ErrNATSConnClosed) || errors.Is(err, constants.ErrCircuitOpen) {
+		t.Errorf("HealthCheck() = %v; want ErrNATSConnClosed", err)
+	}
+}
+
+func TestCircuitBreaker_SuccessResetsFailureRun(t *testing.T) {
+	fail := true
+	cb, _, _ := newTestCircuitBreaker(3, &fail)
+	ctx := context.Background()
+
+	_, _ = cb.Request(ctx, "subj", nil, time.Second)
+	_, _ = cb.Request(ctx, "subj", nil, time.Second)
+	fail = false
+	_, _ = cb.Request(ctx, "subj", nil, time.Second)
+	fail = true
+	_, _ = cb.Request(ctx, "subj", nil, time.Second)
+	_, _ = cb.Request(ctx, "subj", nil, time.Second)
+
+	if cb.state != circuitClosed {
+		t.Errorf("state = %s; want closed after non-consecutive failures", cb.state)
+	}
+}
+
+func TestCircuitBreaker_CallerCancellationIsNotAFailure(t *testing.T) {
+	fail := false
+	cb, _, _ := newTestCircuitBreaker(1, &fail)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := cb.Request(ctx, "subj", nil, time.Second); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v; want context.Canceled", err)
+	}
+	if cb.state != circuitClosed {
+		t.Errorf("state = %s; want closed", cb.state)
+	}
+}
+
+func TestCircuitBreaker_HungBackendOpensCircuit(t *testing.T) {
+	// fga-sync never replies: NATS gives up after the timeout, which is the
+	// caller's remaining deadline, so the caller's context expires with it.
+	// The deadlines are at least the breaker's minimum, so each one counts.
+	calls := 0
+	stub := &stubRepository{requestFunc: func(ctx context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+		calls++
+		<-ctx.Done()
+		return nil, fmt.Errorf("%s: %w: %w", constants.ErrMsgNATSRequestFailed, constants.ErrRequestTimeout, nats.ErrTimeout)
+	}}
+	cb := NewCircuitBreakerRepository(stub, 3, 10*time.Second, time.Millisecond).(*circuitBreakerRepository)
+
+	for i := 0; i < 3; i++ {
+		timeout := 5 * time.Millisecond
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		_, err := cb.Request(ctx, "subj", nil, timeout)
+		cancel()
+		if !errors.Is(err, constants.ErrRequestTimeout) {
+			t.Fatalf("request %d: err = %v; want ErrRequestTimeout", i, err)
+		}
+	}
+
+	if _, err := cb.Request(context.Background(), "subj", nil, time.Second); !errors.Is(err, constants.ErrCircuitOpen) {
+		t.Fatalf("err = %v; want ErrCircuitOpen after %d timeouts", err, calls)
+	}
+	if calls != 3 {
+		t.Errorf("backend called %d times; want 3", calls)
+	}
+}
+
+func TestCircuitBreaker_ShortCallerDeadlinesAreNotFailures(t *testing.T) {
+	// fga-sync is healthy but takes 50ms to reply. Callers asking for 5ms time
+	// out before it does; that is their choice, not a backend failure.
+	stub := &stubRepository{requestFunc: func(ctx context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+		select {
+		case <-time.After(50 * time.Millisecond):
+			return []byte("ok"), nil
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%s: %w: %w", constants.ErrMsgNATSRequestFailed, constants.ErrRequestTimeout, nats.ErrTimeout)
+		}
+	}}
+	cb := NewCircuitBreakerRepository(stub, 3, 10*time.Second, time.Second).(*circuitBreakerRepository)
+
+	for i := 0; i < 10; i++ {
+		timeout := 5 * time.Millisecond
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		_, err := cb.Request(ctx, "subj", nil, timeout)
+		cancel()
+		if !errors.Is(err, constants.ErrRequestTimeout) {
+			t.Fatalf("request %d: err = %v; want ErrRequestTimeout", i, err)
+		}
+	}
+
+	if cb.state != circuitClosed {
+		t.Fatalf("state = %s; want closed after short caller deadlines", cb.state)
+	}
+	if _, err := cb.Request(context.Background(), "subj", nil, time.Second); err != nil {
+		t.Errorf("request with a full timeout: err = %v; want nil", err)
+	}
+}
+
+func TestCircuitBreaker_HalfOpenProbe(t *testing.T) {
+	tests := []struct {
+		name      string
+		probeFail bool
+		wantState circuitState
+	}{
+		{"probe succeeds", false, circuitClosed},
+		{"probe fails", true, circuitOpen},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fail := true
+			cb, now, calls := newTestCircuitBreaker(1, &fail)
+			ctx := context.Background()
+
+			_, _ = cb.Request(ctx, "subj", nil, time.Second)
+			if cb.state != circuitOpen {
+				t.Fatalf("state = %s; want open", cb.state)
+			}
+
+			*now = now.Add(10 * time.Second)
+			if err := cb.HealthCheck(ctx); err != nil {
+				t.Errorf("HealthCheck() after open timeout = %v; want nil", err)
+			}
+
+			fail = tt.probeFail
+			_, _ = cb.Request(ctx, "subj", nil, time.Second)
+			if *calls != 2 {
+				t.Errorf("backend called %d times; want 2 (one probe)", *calls)
+			}
+			if cb.state != tt.wantState {
+				t.Errorf("state = %s; want %s", cb.state, tt.wantState)
+			}
+		})
+	}
+}
+
+func TestCircuitBreaker_SingleProbeInFlight(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var startOnce sync.Once
+	fail := true
+	stub := &stubRepository{requestFunc: func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+		if fail {
+			return nil, errBackend
+		}
+		startOnce.Do(func() { close(started) })
+		<-release
+		return []byte("ok"), nil
+	}}
+	now := time.Unix(1700000000, 0)
+	cb := NewCircuitBreakerRepository(stub, 1, time.Second, time.Second).(*circuitBreakerRepository)
+	cb.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, _ = cb.Request(ctx, "subj", nil, time.Second)
+	now = now.Add(time.Second)
+	fail = false
+
+	probeDone := make(chan error)
+	go func() {
+		_, err := cb.Request(ctx, "subj", nil, time.Second)
+		probeDone <- err
+	}()
+	<-started
+
+	if _, err := cb.Request(ctx, "subj", nil, time.Second); !errors.Is(err, constants.ErrCircuitOpen) {
+		t.Errorf("concurrent request during probe: err = %v; want ErrCircuitOpen", err)
+	}
+
+	close(release)
+	if err := <-probeDone; err != nil {
+		t.Fatalf("probe err = %v", err)
+	}
+	if _, err := cb.Request(ctx, "subj", nil, time.Second); err != nil {
+		t.Errorf("request after successful probe: err = %v", err)
+	}
+}
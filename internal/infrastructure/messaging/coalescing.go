@@ -0,0 +1,164 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package messaging
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// coalescedRequests counts requests that joined an identical in-flight request
+// instead of sending their own.
+var coalescedRequests, _ = meter.Int64Counter("access_check.messaging.coalesced_requests",
+	metric.WithDescription("NATS requests answered by an identical in-flight request"),
+	metric.WithUnit("{request}"),
+)
+
+// inflightRequest is one NATS request shared by every caller that asked for
+// the same subject and payload while it was in flight.
+type inflightRequest struct {
+	done chan struct{}
+	data []byte
+	err  error
+
+	// timeout and expires are the shared request's timeout and the moment
+	// it runs out.
+	timeout time.Duration
+	expires time.Time
+
+	// waiters and cancel are guarded by coalescingRepository.mu.
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// coalescingRepository sends identical concurrent requests (same subject and
+// payload) once and hands the reply to every caller.
+type coalescingRepository struct {
+	contracts.MessagingRepository
+
+	mu       sync.Mutex
+	inflight map[string]*inflightRequest
+}
+
+// NewCoalescingRepository wraps next so that identical in-flight requests are
+// coalesced into a single NATS request. Subscribe, Close and HealthCheck go
+// straight to next.
+func NewCoalescingRepository(next contracts.MessagingRepository) contracts.MessagingRepository {
+	return &coalescingRepository{
+		MessagingRepository: next,
+		inflight:            make(map[string]*inflightRequest),
+	}
+}
+
+// Request joins an identical in-flight request or starts a new one.
+//
+// The shared request runs detached from any single caller's context, so a
+// caller that is cancelled or times out returns ctx.Err() on its own while the
+// others keep waiting. The shared request is cancelled only once every caller
+// has left. Its timeout is the one passed by the caller that started it,
+// clamped to that caller's deadline, and that deadline is carried into the
+// shared context so the repositories below can budget their attempts by it.
+//
+// A caller only joins a request that runs at least until the caller's own
+// deadline or, without one, was started with at least the caller's timeout.
+// Otherwise it starts a new request that later callers join instead, so a
+// caller with a short deadline never cuts the wait of callers with a longer
+// one.
+//
+// Every caller receives the same reply slice and must not modify it.
+func (r *coalescingRepository) Request(ctx context.Context, subject string, data []byte, timeout time.Duration) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	key := subject + "\x00" + string(data)
+
+	deadline, hasDeadline := ctx.Deadline()
+	if hasDeadline {
+		timeout = min(timeout, time.Until(deadline))
+	}
+
+	r.mu.Lock()
+	req, joined := r.inflight[key]
+	if joined {
+		if hasDeadline {
+			joined = !req.expires.Before(deadline)
+		} else {
+			joined = req.timeout >= timeout
+		}
+	}
+	if !joined {
+		expires := time.Now().Add(timeout)
+		var (
+			reqCtx context.Context
+			cancel context.CancelFunc
+		)
+		if hasDeadline {
+			reqCtx, cancel = context.WithDeadline(context.WithoutCancel(ctx), expires)
+		} else {
+			reqCtx, cancel = context.WithCancel(context.WithoutCancel(ctx))
+		}
+		req = &inflightRequest{
+			done:    make(chan struct{}),
+			timeout: timeout,
+			expires: expires,
+			cancel:  cancel,
+		}
+		r.inflight[key] = req
+		go r.send(reqCtx, key, req, subject, data, timeout)
+	}
+	req.waiters++
+	r.mu.Unlock()
+
+	if joined {
+		coalescedRequests.Add(ctx, 1, metric.WithAttributes(semconv.MessagingDestinationName(subject)))
+		trace.SpanFromContext(ctx).AddEvent("nats.request.coalesced",
+			trace.WithAttributes(semconv.MessagingDestinationName(subject)))
+	}
+
+	select {
+	case <-req.done:
+		return req.data, req.err
+	case <-ctx.Done():
+		r.leave(key, req)
+		return nil, ctx.Err()
+	}
+}
+
+// send performs the shared request and releases every waiter.
+func (r *coalescingRepository) send(ctx context.Context, key string, req *inflightRequest, subject string, data []byte, timeout time.Duration) {
+	req.data, req.err = r.MessagingRepository.Request(ctx, subject, data, timeout)
+
+	r.mu.Lock()
+	if r.inflight[key] == req {
+		delete(r.inflight, key)
+	}
+	r.mu.Unlock()
+
+	close(req.done)
+	req.cancel()
+}
+
+// leave drops a cancelled caller from req. When no caller is left the shared
+// request is cancelled and forgotten, so a later caller starts afresh instead
+// of joining a request that is being torn down.
+func (r *coalescingRepository) leave(key string, req *inflightRequest) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	req.waiters--
+	if req.waiters > 0 {
+		return
+	}
+	if r.inflight[key] == req {
+		delete(r.inflight, key)
+	}
+	req.cancel()
+}
@@ -0,0 +1,305 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+package messaging
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+// stubRepository is a MessagingRepository whose Request is provided by the
+// test and whose HealthCheck returns healthErr.
+type stubRepository struct {
+	requestFunc func(ctx context.Context, subject string, data []byte, timeout time.Duration) ([]byte, error)
+	healthErr   error
+}
+
+func (s *stubRepository) Request(ctx context.Context, subject string, data []byte, timeout time.Duration) ([]byte, error) {
+	return s.requestFunc(ctx, subject, data, timeout)
+}
+
+func (s *stubRepository) Subscribe(_ string, _ contracts.MessageHandler) error { return nil }
+
+func (s *stubRepository) Close() error { return nil }
+
+func (s *stubRepository) HealthCheck(_ context.Context) error { return s.healthErr }
+
+// gatedRepository blocks every request until release is closed, counting the
+// requests that reached it and reporting each one's context on started.
+func gatedRepository(calls *atomic.Int32, release <-chan struct{}, started chan<- context.Context) *stubRepository {
+	return &stubRepository{requestFunc: func(ctx context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+		calls.Add(1)
+		if started != nil {
+			started <- ctx
+		}
+		select {
+		case <-release:
+			return append([]byte("reply:"), data...), nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}}
+}
+
+// waitForWaiters blocks until the in-flight request for subject and data has
+// n waiters.
+func waitForWaiters(t *testing.T, r *coalescingRepository, subject string, data string, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		r.mu.Lock()
+		req := r.inflight[subject+"\x00"+data]
+		got := 0
+		if req != nil {
+			got = req.waiters
+		}
+		r.mu.Unlock()
+		if got == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d waiters", n)
+}
+
+func TestCoalescingRepository_IdenticalRequestsShareReply(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	repo := NewCoalescingRepository(gatedRepository(&calls, release, nil)).(*coalescingRepository)
+
+	const waiters = 5
+	var wg sync.WaitGroup
+	replies := make([]string, waiters)
+	errs := make([]error, waiters)
+	for i := 0; i < waiters; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			data, err := repo.Request(context.Background(), "subject", []byte("payload"), time.Second)
+			replies[i], errs[i] = string(data), err
+		}(i)
+	}
+	waitForWaiters(t, repo, "subject", "payload", waiters)
+	close(release)
+	wg.Wait()
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected 1 upstream request, got %d", got)
+	}
+	for i := range replies {
+		if errs[i] != nil || replies[i] != "reply:payload" {
+			t.Errorf("waiter %d got %q, %v", i, replies[i], errs[i])
+		}
+	}
+}
+
+func TestCoalescingRepository_DistinctRequestsNotShared(t *testing.T) {
+	tests := []struct {
+		name            string
+		subjectA, dataA string
+		subjectB, dataB string
+	}{
+		{"different payload", "subject", "a", "subject", "b"},
+		{"different subject", "subject.a", "payload", "subject.b", "payload"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			release := make(chan struct{})
+			close(release)
+			repo := NewCoalescingRepository(gatedRepository(&calls, release, nil))
+
+			if _, err := repo.Request(context.Background(), tt.subjectA, []byte(tt.dataA), time.Second); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, err := repo.Request(context.Background(), tt.subjectB, []byte(tt.dataB), time.Second); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := calls.Load(); got != 2 {
+				t.Errorf("expected 2 upstream requests, got %d", got)
+			}
+		})
+	}
+}
+
+func TestCoalescingRepository_CancelledWaiterDoesNotAffectOthers(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	started := make(chan context.Context, 1)
+	repo := NewCoalescingRepository(gatedRepository(&calls, release, started)).(*coalescingRepository)
+
+	// The first caller starts the shared request and is then cancelled.
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := repo.Request(firstCtx, "subject", []byte("payload"), time.Second)
+		firstErr <- err
+	}()
+	upstreamCtx := <-started
+
+	secondReply := make(chan string, 1)
+	go func() {
+		data, err := repo.Request(context.Background(), "subject", []byte("payload"), time.Second)
+		if err != nil {
+			secondReply <- err.Error()
+			return
+		}
+		secondReply <- string(data)
+	}()
+	waitForWaiters(t, repo, "subject", "payload", 2)
+
+	cancelFirst()
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected cancelled caller to get context.Canceled, got %v", err)
+	}
+	if upstreamCtx.Err() != nil {
+		t.Fatal("shared request was cancelled while a waiter remained")
+	}
+
+	close(release)
+	if got := <-secondReply; got != "reply:payload" {
+		t.Errorf("expected remaining waiter to get the reply, got %q", got)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected 1 upstream request, got %d", got)
+	}
+}
+
+func TestCoalescingRepository_LastWaiterLeavingCancelsRequest(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan context.Context, 2)
+	repo := NewCoalescingRepository(gatedRepository(&calls, release, started))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = repo.Request(ctx, "subject", []byte("payload"), time.Second)
+	}()
+	upstreamCtx := <-started
+	cancel()
+	<-done
+
+	select {
+	case <-upstreamCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected shared request to be cancelled once every caller left")
+	}
+
+	// A later identical request must start afresh.
+	go func() {
+		_, _ = repo.Request(context.Background(), "subject", []byte("payload"), time.Second)
+	}()
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("expected a new upstream request after the previous one was abandoned")
+	}
+}
+
+func TestCoalescingRepository_CallerDeadlines(t *testing.T) {
+	tests := []struct {
+		name          string
+		first, second time.Duration
+		wantCalls     int32
+	}{
+		// A caller never joins a request that would time out before its own
+		// deadline, so the short deadline does not fail the long one.
+		{name: "short deadline first", first: 50 * time.Millisecond, second: time.Second, wantCalls: 2},
+		{name: "long deadline first", first: time.Second, second: 50 * time.Millisecond, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			release := make(chan struct{})
+			started := make(chan struct{}, 2)
+			// Like the NATS repository, the stub gives up after the request timeout.
+			repo := NewCoalescingRepository(&stubRepository{requestFunc: func(ctx context.Context, _ string, _ []byte, timeout time.Duration) ([]byte, error) {
+				calls.Add(1)
+				started <- struct{}{}
+				select {
+				case <-release:
+					return []byte("reply"), nil
+				case <-time.After(timeout):
+					return nil, constants.ErrRequestTimeout
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				}
+			}})
+
+			request := func(deadline time.Duration) <-chan error {
+				errc := make(chan error, 1)
+				go func() {
+					ctx, cancel := context.WithTimeout(context.Background(), deadline)
+					defer cancel()
+					_, err := repo.Request(ctx, "subject", []byte("payload"), deadline)
+					errc <- err
+				}()
+				return errc
+			}
+
+			firstErr := request(tt.first)
+			<-started
+			secondErr := request(tt.second)
+
+			// Reply once the short deadline has passed.
+			shortErr, longErr := firstErr, secondErr
+			if tt.first > tt.second {
+				shortErr, longErr = secondErr, firstErr
+			}
+			if err := <-shortErr; err == nil {
+				t.Error("short-deadline caller succeeded; want it to time out")
+			}
+			close(release)
+			if err := <-longErr; err != nil {
+				t.Errorf("long-deadline caller failed: %v", err)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("expected %d upstream requests, got %d", tt.wantCalls, got)
+			}
+		})
+	}
+}
+
+func TestCoalescingRepository_ErrorSharedAndNotRetained(t *testing.T) {
+	var calls atomic.Int32
+	upstreamErr := errors.New("nats: no responders available for request")
+	repo := NewCoalescingRepository(&stubRepository{requestFunc: func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+		calls.Add(1)
+		return nil, upstreamErr
+	}})
+
+	for i := 0; i < 2; i++ {
+		if _, err := repo.Request(context.Background(), "subject", []byte("payload"), time.Second); !errors.Is(err, upstreamErr) {
+			t.Fatalf("expected upstream error, got %v", err)
+		}
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected completed requests not to be reused, got %d upstream requests", got)
+	}
+}
+
+func TestCoalescingRepository_CancelledContextNotSent(t *testing.T) {
+	var calls atomic.Int32
+	repo := NewCoalescingRepository(gatedRepository(&calls, nil, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := repo.Request(ctx, "subject", []byte("payload"), time.Second); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if got := calls.Load(); got != 0 {
+		t.Errorf("expected no upstream request, got %d", got)
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package messaging
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// hedgeLatencyWindow is the number of recent reply latencies per subject
+	// the hedge delay is computed from.
+	hedgeLatencyWindow = 256
+	// hedgeMinSamples is the number of latencies a subject needs before its
+	// requests are hedged, so a cold start does not hedge on noise.
+	hedgeMinSamples = 20
+	// hedgeBudgetBurst caps the hedges that can be sent back to back after a
+	// quiet period.
+	hedgeBudgetBurst = 10.0
+)
+
+var hedgeDelayKey = attribute.Key("messaging.hedge.delay_ms")
+
+var (
+	hedgesFired, _ = meter.Int64Counter("access_check.messaging.hedges",
+		metric.WithDescription("Hedge requests sent because the first reply was slower than the hedge delay"),
+		metric.WithUnit("{request}"),
+	)
+	hedgesWon, _ = meter.Int64Counter("access_check.messaging.hedge_wins",
+		metric.WithDescription("Hedge requests whose reply arrived before the original request's"),
+		metric.WithUnit("{request}"),
+	)
+	hedgesSkipped, _ = meter.Int64Counter("access_check.messaging.hedges_over_budget",
+		metric.WithDescription("Hedge requests not sent because the hedge budget was spent"),
+		metric.WithUnit("{request}"),
+	)
+)
+
+// HedgePolicy configures hedged requests.
+type HedgePolicy struct {
+	// Percentile of recent reply latencies (1-99) after which a request
+	// without a reply is hedged.
+	Percentile int
+	// MinDelay is the shortest wait before hedging, however fast recent
+	// replies were.
+	MinDelay time.Duration
+	// BudgetPercent caps hedges as a percentage of requests.
+	BudgetPercent int
+	// Subjects lists the subjects that are safe to send more than once.
+	// Requests on any other subject are never hedged.
+	Subjects []string
+}
+
+// hedgingRepository sends a second copy of a request that has not been
+// answered within a high percentile of recent latencies. Replies come from
+// a queue group, so the copy usually lands on another replica; whichever
+// reply arrives first is returned and the other copy's context is cancelled,
+// so its reply is no longer awaited. The replier still processes it.
+type hedgingRepository struct {
+	contracts.MessagingRepository
+
+	policy HedgePolicy
+
+	mu        sync.Mutex
+	latencies map[string]*latencyWindow
+	tokens    float64
+}
+
+// NewHedgingRepository wraps next so that slow requests on policy.Subjects are
+// hedged. Subscribe, Close and HealthCheck go straight to next.
+func NewHedgingRepository(next contracts.MessagingRepository, policy HedgePolicy) contracts.MessagingRepository {
+	return &hedgingRepository{
+		MessagingRepository: next,
+		policy:              policy,
+		latencies:           make(map[string]*latencyWindow),
+		tokens:              hedgeBudgetBurst,
+	}
+}
+
+// hedgeReply is the outcome of one copy of a hedged request.
+type hedgeReply struct {
+	data    []byte
+	err     error
+	hedge   bool
+	latency time.Duration
+}
+
+// Request sends the request and, if no reply has arrived after the hedge
+// delay and the budget allows, a second identical one. The first successful
+// reply wins. If a copy fails while the other is still in flight, the other
+// is awaited; if both fail the last error is returned.
+func (r *hedgingRepository) Request(ctx context.Context, subject string, data []byte, timeout time.Duration) ([]byte, error) {
+	if !slices.Contains(r.policy.Subjects, subject) {
+		return r.MessagingRepository.Request(ctx, subject, data, timeout)
+	}
+
+	delay, ok := r.delay(subject)
+	if !ok {
+		reply := r.send(ctx, subject, data, timeout, false)
+		r.observe(subject, reply)
+		return reply.data, reply.err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	replies := make(chan hedgeReply, 2)
+	go func() {
+		reply := r.send(ctx, subject, data, timeout, false)
+		replies <- reply
+	}()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	pending := 1
+	for {
+		select {
+		case <-timer.C:
+			if !r.spend() {
+				hedgesSkipped.Add(ctx, 1, metric.WithAttributes(semconv.MessagingDestinationName(subject)))
+				continue
+			}
+			hedgesFired.Add(ctx, 1, metric.WithAttributes(semconv.MessagingDestinationName(subject)))
+			trace.SpanFromContext(ctx).AddEvent("nats.request.hedged", trace.WithAttributes(
+				semconv.MessagingDestinationName(subject),
+				hedgeDelayKey.Int64(delay.Milliseconds()),
+			))
+			pending++
+			go func() {
+				reply := r.send(ctx, subject, data, timeout, true)
+				replies <- reply
+			}()
+		case reply := <-replies:
+			pending--
+			if reply.err == nil {
+				if reply.hedge {
+					hedgesWon.Add(ctx, 1, metric.WithAttributes(semconv.MessagingDestinationName(subject)))
+				}
+				r.observe(subject, reply)
+				return reply.data, nil
+			}
+			// A failure before the hedge delay is not hedged; retrying is
+			// left to the retry policy.
+			if pending == 0 {
+				return nil, reply.err
+			}
+		}
+	}
+}
+
+// send performs one copy of the request and times it.
+func (r *hedgingRepository) send(ctx context.Context, subject string, data []byte, timeout time.Duration, hedge bool) hedgeReply {
+	start := time.Now()
+	reply, err := r.MessagingRepository.Request(ctx, subject, data, timeout)
+	return hedgeReply{data: reply, err: err, hedge: hedge, latency: time.Since(start)}
+}
+
+// delay returns how long to wait for a reply on subject before hedging, and
+// false while too few replies have been seen to tell what is slow. Every
+// call also earns the hedge budget its share of a hedge.
+func (r *hedgingRepository) delay(subject string) (time.Duration, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens = min(r.tokens+float64(r.policy.BudgetPercent)/100, hedgeBudgetBurst)
+
+	w := r.latencies[subject]
+	if w == nil || w.len() < hedgeMinSamples {
+		return 0, false
+	}
+	return max(w.percentile(r.policy.Percentile), r.policy.MinDelay), true
+}
+
+// spend takes one hedge from the budget, reporting false when it is empty.
+func (r *hedgingRepository) spend() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.tokens < 1 {
+		return false
+	}
+	r.tokens--
+	return true
+}
+
+// observe records the latency of a successful reply.
+func (r *hedgingRepository) observe(subject string, reply hedgeReply) {
+	if reply.err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	w := r.latencies[subject]
+	if w == nil {
+		w = &latencyWindow{}
+		r.latencies[subject] = w
+	}
+	w.add(reply.latency)
+}
+
+// latencyWindow holds the most recent hedgeLatencyWindow reply latencies.
+type latencyWindow struct {
+	samples [hedgeLatencyWindow]time.Duration
+	next    int
+	full    bool
+}
+
+func (w *latencyWindow) add(d time.Duration) {
+	w.samples[w.next] = d
+	w.next = (w.next + 1) % hedgeLatencyWindow
+	if w.next == 0 {
+		w.full = true
+	}
+}
+
+func (w *latencyWindow) len() int {
+	if w.full {
+		return hedgeLatencyWindow
+	}
+	return w.next
+}
+
+// percentile returns the p-th percentile (nearest rank) of the window.
+func (w *latencyWindow) percentile(p int) time.Duration {
+	sorted := slices.Clone(w.samples[:w.len()])
+	slices.Sort(sorted)
+	rank := min((p*len(sorted)+99)/100, len(sorted))
+	return sorted[max(rank-1, 0)]
+}
@@ -0,0 +1,157 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+package messaging
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+// newTestHedgingRepository returns a hedging repository whose latency window
+// for AccessCheckSubject is already warm with 1ms replies, so requests are
+// hedged after MinDelay.
+func newTestHedgingRepository(requestFunc func(ctx context.Context, call int32) ([]byte, error)) (*hedgingRepository, *atomic.Int32) {
+	var calls atomic.Int32
+	stub := &stubRepository{requestFunc: func(ctx context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+		return requestFunc(ctx, calls.Add(1))
+	}}
+	r := NewHedgingRepository(stub, HedgePolicy{
+		Percentile:    95,
+		MinDelay:      10 * time.Millisecond,
+		BudgetPercent: 10,
+		Subjects:      []string{constants.AccessCheckSubject},
+	}).(*hedgingRepository)
+	for i := 0; i < hedgeMinSamples; i++ {
+		r.observe(constants.AccessCheckSubject, hedgeReply{latency: time.Millisecond})
+	}
+	return r, &calls
+}
+
+// blockUntilDone stands in for a slow replica.
+func blockUntilDone(ctx context.Context) ([]byte, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestHedgingRepository_HedgeWins(t *testing.T) {
+	r, calls := newTestHedgingRepository(func(ctx context.Context, call int32) ([]byte, error) {
+		if call == 1 {
+			return blockUntilDone(ctx)
+		}
+		return []byte("hedge"), nil
+	})
+
+	reply, err := r.Request(context.Background(), constants.AccessCheckSubject, []byte("req"), time.Second)
+	if err != nil || string(reply) != "hedge" {
+		t.Fatalf("Request() = %q, %v; want hedge reply", reply, err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("backend called %d times; want 2", calls.Load())
+	}
+}
+
+func TestHedgingRepository_FastReplyIsNotHedged(t *testing.T) {
+	r, calls := newTestHedgingRepository(func(_ context.Context, _ int32) ([]byte, error) {
+		return []byte("ok"), nil
+	})
+
+	if _, err := r.Request(context.Background(), constants.AccessCheckSubject, []byte("req"), time.Second); err != nil {
+		t.Fatalf("Request() err = %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("backend called %d times; want 1", calls.Load())
+	}
+}
+
+func TestHedgingRepository_NotHedged(t *testing.T) {
+	tests := []struct {
+		name    string
+		subject string
+		prepare func(r *hedgingRepository)
+	}{
+		{"budget spent", constants.AccessCheckSubject, func(r *hedgingRepository) { r.tokens = 0 }},
+		{"subject not hedged", "lfx.other.subject", func(*hedgingRepository) {}},
+		{"latency window cold", constants.AccessCheckSubject, func(r *hedgingRepository) { clear(r.latencies) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, calls := newTestHedgingRepository(func(_ context.Context, _ int32) ([]byte, error) {
+				time.Sleep(30 * time.Millisecond)
+				return []byte("slow"), nil
+			})
+			tt.prepare(r)
+
+			reply, err := r.Request(context.Background(), tt.subject, []byte("req"), time.Second)
+			if err != nil || string(reply) != "slow" {
+				t.Fatalf("Request() = %q, %v; want slow reply", reply, err)
+			}
+			if calls.Load() != 1 {
+				t.Errorf("backend called %d times; want 1", calls.Load())
+			}
+		})
+	}
+}
+
+func TestHedgingRepository_WaitsForOtherCopyOnFailure(t *testing.T) {
+	errReplica := errors.New("replica failed")
+	r, _ := newTestHedgingRepository(func(_ context.Context, call int32) ([]byte, error) {
+		if call == 1 {
+			time.Sleep(30 * time.Millisecond)
+			return []byte("original"), nil
+		}
+		return nil, errReplica
+	})
+
+	reply, err := r.Request(context.Background(), constants.AccessCheckSubject, []byte("req"), time.Second)
+	if err != nil || string(reply) != "original" {
+		t.Fatalf("Request() = %q, %v; want original reply after hedge failed", reply, err)
+	}
+}
+
+func TestHedgingRepository_BothCopiesFail(t *testing.T) {
+	errReplica := errors.New("replica failed")
+	r, _ := newTestHedgingRepository(func(_ context.Context, call int32) ([]byte, error) {
+		if call == 1 {
+			time.Sleep(30 * time.Millisecond)
+		}
+		return nil, errReplica
+	})
+
+	if _, err := r.Request(context.Background(), constants.AccessCheckSubject, []byte("req"), time.Second); !errors.Is(err, errReplica) {
+		t.Errorf("err = %v; want %v", err, errReplica)
+	}
+}
+
+func TestLatencyWindow_Percentile(t *testing.T) {
+	w := &latencyWindow{}
+	for i := 1; i <= 100; i++ {
+		w.add(time.Duration(i) * time.Millisecond)
+	}
+
+	tests := []struct {
+		p    int
+		want time.Duration
+	}{
+		{50, 50 * time.Millisecond},
+		{95, 95 * time.Millisecond},
+		{99, 99 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		if got := w.percentile(tt.p); got != tt.want {
+			t.Errorf("percentile(%d) = %v; want %v", tt.p, got, tt.want)
+		}
+	}
+
+	for i := 0; i < hedgeLatencyWindow; i++ {
+		w.add(time.Second)
+	}
+	if got := w.percentile(50); got != time.Second {
+		t.Errorf("percentile(50) after window rolled over = %v; want 1s", got)
+	}
+}
@@ -6,6 +6,7 @@ package messaging
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -26,6 +27,9 @@ import (
 // call time, so otel.SetTracerProvider() updates it regardless of init order.
 var tracer = otel.Tracer("github.com/linuxfoundation/lfx-v2-access-check/internal/infrastructure/messaging")
 
+// meter follows the same delegation rules as tracer.
+var meter = otel.Meter("github.com/linuxfoundation/lfx-v2-access-check/internal/infrastructure/messaging")
+
 // messagingReplyBodySizeKey records the size of the NATS reply payload.
 // Named to distinguish it from messaging.message.body.size (the outbound request).
 // There is no semconv standard attribute for reply size yet.
@@ -142,11 +146,16 @@ func (r *messagingRepository) Request(ctx context.Context, subject string, data
 	natsMsg.Data = data
 	otel.GetTextMapPropagator().Inject(ctx, natsHeaderCarrier(natsMsg.Header))
 
-	msg, err := r.conn.RequestMsg(natsMsg, timeout)
+	// Bind the request to ctx so that a caller that gives up, such as the
+	// losing copy of a hedged request, stops waiting for its reply.
+	reqCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	msg, err := r.conn.RequestMsgWithContext(reqCtx, natsMsg)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, constants.ErrMsgNATSRequestFailed)
-		return nil, fmt.Errorf("%s: %w", constants.ErrMsgNATSRequestFailed, err)
+		return nil, requestError(ctx, err)
 	}
 
 	span.SetAttributes(messagingReplyBodySizeKey.Int(len(msg.Data)))
@@ -154,6 +163,58 @@ func (r *messagingRepository) Request(ctx context.Context, subject string, data
 	return msg.Data, nil
 }
 
+// requestError wraps the error of a failed request. Cancellation of ctx is
+// returned as ctx.Err(); running out of time, whether on the request timeout
+// or on ctx's deadline, is reported as ErrRequestTimeout and nats.ErrTimeout.
+func requestError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, context.Canceled) && ctx.Err() != nil:
+		return ctx.Err()
+	case errors.Is(err, context.DeadlineExceeded):
+		return fmt.Errorf("%s: %w: %w", constants.ErrMsgNATSRequestFailed, constants.ErrRequestTimeout, nats.ErrTimeout)
+	case errors.Is(err, nats.ErrTimeout):
+		return fmt.Errorf("%s: %w: %w", constants.ErrMsgNATSRequestFailed, constants.ErrRequestTimeout, err)
+	default:
+		return fmt.Errorf("%s: %w", constants.ErrMsgNATSRequestFailed, err)
+	}
+}
+
+// Subscribe delivers every message published on subject to handler. The
+// publisher's trace context is extracted from the message headers and each
+// delivery runs in its own consumer span. The subscription is drained along
+// with the connection on Close.
+func (r *messagingRepository) Subscribe(subject string, handler contracts.MessageHandler) error {
+	if r.conn == nil {
+		return constants.ErrNATSConnNotInit
+	}
+
+	_, err := r.conn.Subscribe(subject, func(msg *nats.Msg) {
+		ctx := context.Background()
+		if msg.Header != nil {
+			ctx = otel.GetTextMapPropagator().Extract(ctx, natsHeaderCarrier(msg.Header))
+		}
+		ctx, span := tracer.Start(ctx, "nats.process",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(
+				semconv.MessagingSystemKey.String("nats"),
+				semconv.MessagingOperationTypeProcess,
+				semconv.MessagingDestinationName(msg.Subject),
+				semconv.MessagingMessageBodySize(len(msg.Data)),
+			),
+		)
+		defer span.End()
+
+		handler(ctx, msg.Subject, msg.Data)
+	})
+	if err != nil {
+		slog.Error("Failed to subscribe to NATS subject", "error", err, "subject", subject)
+		return fmt.Errorf("%s: %w", constants.ErrMsgNATSSubscribeFailed, err)
+	}
+
+	slog.Info("Subscribed to NATS subject", "subject", subject)
+	return nil
+}
+
 // Close closes the NATS connection gracefully
 func (r *messagingRepository) Close() error {
 	if r.conn != nil {
@@ -4,11 +4,14 @@ package messaging
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+	"github.com/nats-io/nats.go"
 	"go.opentelemetry.io/otel"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
@@ -60,6 +63,14 @@ func TestNewMessagingRepository_LocalhostNoServer(t *testing.T) {
 	t.Logf("Got expected connection error: %v", err)
 }
 
+func TestMessagingRepository_Subscribe_NilConnection(t *testing.T) {
+	repo := &messagingRepository{conn: nil}
+	err := repo.Subscribe("test.subject", func(_ context.Context, _ string, _ []byte) {})
+	if err == nil {
+		t.Error("Expected error for nil connection, got none")
+	}
+}
+
 func TestMessagingRepository_Close_NilConnection(_ *testing.T) {
 	repo := &messagingRepository{conn: nil}
 	_ = repo.Close() // Should not panic
@@ -272,3 +283,59 @@ func TestMessagingRepository_Request_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestError(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		err     error
+		want    []error
+		notWant []error
+	}{
+		{
+			name: "request timeout",
+			ctx:  context.Background(),
+			err:  context.DeadlineExceeded,
+			want: []error{constants.ErrRequestTimeout, nats.ErrTimeout},
+		},
+		{
+			name: "NATS timeout",
+			ctx:  context.Background(),
+			err:  nats.ErrTimeout,
+			want: []error{constants.ErrRequestTimeout, nats.ErrTimeout},
+		},
+		{
+			name:    "caller cancelled",
+			ctx:     cancelled,
+			err:     context.Canceled,
+			want:    []error{context.Canceled},
+			notWant: []error{constants.ErrRequestTimeout},
+		},
+		{
+			name:    "no responders",
+			ctx:     context.Background(),
+			err:     nats.ErrNoResponders,
+			want:    []error{nats.ErrNoResponders},
+			notWant: []error{constants.ErrRequestTimeout},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := requestError(tt.ctx, tt.err)
+			for _, want := range tt.want {
+				if !errors.Is(err, want) {
+					t.Errorf("requestError() = %v; want it to match %v", err, want)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if errors.Is(err, notWant) {
+					t.Errorf("requestError() = %v; want it not to match %v", err, notWant)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package messaging
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	retryAttemptKey = attribute.Key("messaging.retry.attempt")
+	retryBackoffKey = attribute.Key("messaging.retry.backoff_ms")
+)
+
+// retriedRequests counts NATS requests sent again after a transient failure.
+var retriedRequests, _ = meter.Int64Counter("access_check.messaging.retries",
+	metric.WithDescription("NATS requests retried after a transient failure"),
+	metric.WithUnit("{request}"),
+)
+
+// RetryPolicy configures which requests are retried and how long to wait
+// between attempts.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	MaxAttempts int
+	// InitialBackoff is the base wait before the first retry; it doubles for
+	// every further retry up to MaxBackoff.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the base wait between attempts.
+	MaxBackoff time.Duration
+	// MinAttemptTimeout is the least time an attempt is given when the
+	// deadline is split among the attempts that remain. It never extends an
+	// attempt past the request timeout or the deadline.
+	MinAttemptTimeout time.Duration
+	// Subjects lists the subjects that are safe to send more than once.
+	// Requests on any other subject get a single attempt.
+	Subjects []string
+}
+
+// retryingRepository retries idempotent requests that failed with a transient
+// NATS error, such as no responders during a rolling deploy of the replier.
+// A timeout is only retried when policy.MinAttemptTimeout leaves part of the
+// deadline to a later attempt; with the default minimum it does not.
+type retryingRepository struct {
+	contracts.MessagingRepository
+
+	policy RetryPolicy
+
+	// jitter spreads a base backoff over [d/2, d); tests replace it.
+	jitter func(d time.Duration) time.Duration
+}
+
+// NewRetryingRepository wraps next so that requests on policy.Subjects are
+// retried with exponential backoff and jitter. Subscribe, Close and
+// HealthCheck go straight to next.
+func NewRetryingRepository(next contracts.MessagingRepository, policy RetryPolicy) contracts.MessagingRepository {
+	return &retryingRepository{
+		MessagingRepository: next,
+		policy:              policy,
+		jitter:              equalJitter,
+	}
+}
+
+// Request sends the request, retrying transient failures while attempts
+// remain and the wait fits within ctx's deadline. Every attempt is recorded
+// as a span event. When no retry is possible the last error is returned.
+//
+// Under a deadline each attempt gets an equal share of the time left, but
+// never less than policy.MinAttemptTimeout, so an attempt that times out
+// leaves time for the next one without cutting short a slow but healthy reply.
+func (r *retryingRepository) Request(ctx context.Context, subject string, data []byte, timeout time.Duration) ([]byte, error) {
+	if !slices.Contains(r.policy.Subjects, subject) {
+		return r.MessagingRepository.Request(ctx, subject, data, timeout)
+	}
+
+	span := trace.SpanFromContext(ctx)
+	for attempt := 1; ; attempt++ {
+		reply, err := r.MessagingRepository.Request(ctx, subject, data, r.attemptTimeout(ctx, timeout, attempt))
+		if err == nil {
+			span.AddEvent("nats.request.attempt", trace.WithAttributes(retryAttemptKey.Int(attempt)))
+			return reply, nil
+		}
+
+		span.AddEvent("nats.request.attempt", trace.WithAttributes(
+			retryAttemptKey.Int(attempt),
+			attribute.String("error", err.Error()),
+		))
+		if attempt >= r.policy.MaxAttempts || ctx.Err() != nil || !isTransient(err) {
+			return nil, err
+		}
+
+		backoff := r.jitter(r.backoff(attempt))
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= backoff {
+			return nil, err
+		}
+
+		slog.DebugContext(ctx, "retrying NATS request after transient failure",
+			"subject", subject, "attempt", attempt, "backoff", backoff, "error", err)
+		retriedRequests.Add(ctx, 1, metric.WithAttributes(semconv.MessagingDestinationName(subject)))
+		span.AddEvent("nats.request.retry", trace.WithAttributes(
+			retryAttemptKey.Int(attempt+1),
+			retryBackoffKey.Int64(backoff.Milliseconds()),
+		))
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// attemptTimeout returns the timeout of attempt: an equal share of the time
+// left before ctx's deadline among the attempts that remain, raised to
+// policy.MinAttemptTimeout and capped at both timeout and the time left.
+func (r *retryingRepository) attemptTimeout(ctx context.Context, timeout time.Duration, attempt int) time.Duration {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return timeout
+	}
+	left := time.Until(deadline)
+	attemptsLeft := max(r.policy.MaxAttempts-attempt+1, 1)
+	share := max(left/time.Duration(attemptsLeft), r.policy.MinAttemptTimeout)
+	return min(timeout, left, share)
+}
+
+// backoff returns the base wait before the retry that follows attempt.
+func (r *retryingRepository) backoff(attempt int) time.Duration {
+	d := r.policy.InitialBackoff
+	for i := 1; i < attempt && d < r.policy.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, r.policy.MaxBackoff)
+}
+
+// isTransient reports whether err is a NATS failure that a later attempt may
+// not hit: nobody was subscribed to the subject, or no reply came in time. A
+// timeout only leads to a retry when the deadline has time left for one.
+func isTransient(err error) bool {
+	return errors.Is(err, nats.ErrNoResponders) || errors.Is(err, nats.ErrTimeout)
+}
+
+// equalJitter returns a random duration in [d/2, d), so concurrent callers
+// retrying after the same failure do not arrive in lockstep.
+func equalJitter(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+	half := d / 2
+	return half + rand.N(d-half)
+}
@@ -0,0 +1,217 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+	"github.com/nats-io/nats.go"
+)
+
+// newTestRetryingRepository returns a retrying repository whose backend
+// fails with errs in turn and then succeeds, and a counter of attempts.
+func newTestRetryingRepository(maxAttempts int, errs ...error) (*retryingRepository, *int) {
+	calls := 0
+	stub := &stubRepository{requestFunc: func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+		calls++
+		if calls <= len(errs) {
+			return nil, fmt.Errorf("%s: %w", constants.ErrMsgNATSRequestFailed, errs[calls-1])
+		}
+		return []byte("ok"), nil
+	}}
+	r := NewRetryingRepository(stub, RetryPolicy{
+		MaxAttempts:    maxAttempts,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     4 * time.Millisecond,
+		Subjects:       []string{constants.AccessCheckSubject},
+	}).(*retryingRepository)
+	return r, &calls
+}
+
+func TestRetryingRepository_Request(t *testing.T) {
+	errOther := errors.New("nats: permissions violation")
+
+	tests := []struct {
+		name        string
+		subject     string
+		maxAttempts int
+		errs        []error
+		wantCalls   int
+		wantErr     error
+	}{
+		{"success needs one attempt", constants.AccessCheckSubject, 3, nil, 1, nil},
+		{"no responders is retried", constants.AccessCheckSubject, 3, []error{nats.ErrNoResponders}, 2, nil},
+		{"timeout is retried", constants.AccessCheckSubject, 3, []error{nats.ErrTimeout, nats.ErrTimeout}, 3, nil},
+		{"attempts are capped", constants.AccessCheckSubject, 2, []error{nats.ErrNoResponders, nats.ErrNoResponders}, 2, nats.ErrNoResponders},
+		{"other errors are not retried", constants.AccessCheckSubject, 3, []error{errOther}, 1, errOther},
+		{"other subjects get one attempt", "lfx.other.subject", 3, []error{nats.ErrNoResponders}, 1, nats.ErrNoResponders},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, calls := newTestRetryingRepository(tt.maxAttempts, tt.errs...)
+
+			reply, err := r.Request(context.Background(), tt.subject, []byte("req"), time.Second)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("err = %v; want %v", err, tt.wantErr)
+				}
+			} else if err != nil || string(reply) != "ok" {
+				t.Errorf("Request() = %q, %v; want ok", reply, err)
+			}
+			if *calls != tt.wantCalls {
+				t.Errorf("backend called %d times; want %d", *calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryingRepository_StopsAtDeadline(t *testing.T) {
+	r, calls := newTestRetryingRepository(5, nats.ErrNoResponders, nats.ErrNoResponders)
+	r.policy.InitialBackoff = time.Second
+	r.policy.MaxBackoff = time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := r.Request(ctx, constants.AccessCheckSubject, []byte("req"), time.Second)
+	if !errors.Is(err, nats.ErrNoResponders) {
+		t.Errorf("err = %v; want the last attempt's error", err)
+	}
+	if *calls != 1 {
+		t.Errorf("backend called %d times; want 1", *calls)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("Request waited %v for a backoff that could not fit the deadline", elapsed)
+	}
+}
+
+func TestRetryingRepository_TimeoutRetriedWithinDeadline(t *testing.T) {
+	// The first attempt hangs until its timeout; the retry replies at once.
+	calls := 0
+	stub := &stubRepository{requestFunc: func(ctx context.Context, _ string, _ []byte, timeout time.Duration) ([]byte, error) {
+		calls++
+		if calls > 1 {
+			return []byte("ok"), nil
+		}
+		select {
+		case <-time.After(timeout):
+			return nil, fmt.Errorf("%s: %w: %w", constants.ErrMsgNATSRequestFailed, constants.ErrRequestTimeout, nats.ErrTimeout)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}}
+	r := NewRetryingRepository(stub, RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		Subjects:       []string{constants.AccessCheckSubject},
+	})
+
+	// As for a caller deadline, the request timeout is the time left.
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	reply, err := r.Request(ctx, constants.AccessCheckSubject, []byte("req"), 300*time.Millisecond)
+	if err != nil || string(reply) != "ok" {
+		t.Fatalf("Request() = %q, %v; want the retry's reply", reply, err)
+	}
+	if calls != 2 {
+		t.Errorf("backend called %d times; want 2", calls)
+	}
+}
+
+func TestRetryingRepository_TimeoutNotRetriedByDefault(t *testing.T) {
+	// With the default policy the first attempt keeps the whole default
+	// budget, so a timeout leaves nothing for a retry.
+	policy := RetryPolicy{
+		MaxAttempts:       constants.DefaultNATSRetryMaxAttempts,
+		InitialBackoff:    constants.DefaultNATSRetryInitialBackoff,
+		MaxBackoff:        constants.DefaultNATSRetryMaxBackoff,
+		MinAttemptTimeout: constants.DefaultNATSRetryMinAttemptTimeout,
+		Subjects:          []string{constants.AccessCheckSubject},
+	}
+
+	r := &retryingRepository{policy: policy}
+	budgetCtx, cancelBudget := context.WithTimeout(context.Background(), constants.DefaultNATSTimeout)
+	defer cancelBudget()
+	if got := r.attemptTimeout(budgetCtx, constants.DefaultNATSTimeout, 1); got < constants.DefaultNATSTimeout-50*time.Millisecond {
+		t.Errorf("first attempt timeout = %v; want the whole %v budget", got, constants.DefaultNATSTimeout)
+	}
+
+	calls := 0
+	stub := &stubRepository{requestFunc: func(ctx context.Context, _ string, _ []byte, timeout time.Duration) ([]byte, error) {
+		calls++
+		select {
+		case <-time.After(timeout):
+		case <-ctx.Done():
+		}
+		return nil, fmt.Errorf("%s: %w: %w", constants.ErrMsgNATSRequestFailed, constants.ErrRequestTimeout, nats.ErrTimeout)
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := NewRetryingRepository(stub, policy).Request(ctx, constants.AccessCheckSubject, []byte("req"), 100*time.Millisecond)
+	if !errors.Is(err, nats.ErrTimeout) {
+		t.Fatalf("Request() error = %v; want the timeout", err)
+	}
+	if calls != 1 {
+		t.Errorf("backend called %d times; want 1, a timeout is not retried by default", calls)
+	}
+}
+
+func TestRetryingRepository_Backoff(t *testing.T) {
+	r := &retryingRepository{policy: RetryPolicy{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}}
+
+	want := []time.Duration{10, 20, 40, 50, 50}
+	for i, w := range want {
+		if got := r.backoff(i + 1); got != w*time.Millisecond {
+			t.Errorf("backoff(%d) = %v; want %v", i+1, got, w*time.Millisecond)
+		}
+	}
+
+	for i := 0; i < 100; i++ {
+		if d := equalJitter(40 * time.Millisecond); d < 20*time.Millisecond || d >= 40*time.Millisecond {
+			t.Fatalf("equalJitter(40ms) = %v; want within [20ms, 40ms)", d)
+		}
+	}
+}
+
+func TestRetryingRepository_AttemptTimeout(t *testing.T) {
+	tests := []struct {
+		name       string
+		minTimeout time.Duration
+		timeout    time.Duration
+		left       time.Duration
+		attempt    int
+		want       time.Duration
+	}{
+		{"deadline split among attempts", 0, 10 * time.Second, 3 * time.Second, 1, time.Second},
+		{"last attempt gets the rest", 0, 10 * time.Second, 3 * time.Second, 3, 3 * time.Second},
+		{"minimum raises the share", 2 * time.Second, 10 * time.Second, 3 * time.Second, 1, 2 * time.Second},
+		{"minimum capped at the time left", 5 * time.Second, 10 * time.Second, 3 * time.Second, 1, 3 * time.Second},
+		{"capped at the request timeout", 5 * time.Second, time.Second, 3 * time.Second, 1, time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &retryingRepository{policy: RetryPolicy{MaxAttempts: 3, MinAttemptTimeout: tt.minTimeout}}
+			ctx, cancel := context.WithTimeout(context.Background(), tt.left)
+			defer cancel()
+
+			got := r.attemptTimeout(ctx, tt.timeout, tt.attempt)
+			if got > tt.want || got < tt.want-50*time.Millisecond {
+				t.Errorf("attemptTimeout() = %v; want about %v", got, tt.want)
+			}
+		})
+	}
+
+	r := &retryingRepository{policy: RetryPolicy{MaxAttempts: 3}}
+	if got := r.attemptTimeout(context.Background(), time.Second, 1); got != time.Second {
+		t.Errorf("attemptTimeout() without a deadline = %v; want the request timeout", got)
+	}
+}
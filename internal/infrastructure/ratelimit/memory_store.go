@@ -0,0 +1,51 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+// Package ratelimit provides the stores that keep per-caller rate-limit state.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/cache"
+)
+
+// memoryStore keeps token buckets in process. Each replica limits callers on
+// its own, so the effective limit grows with the number of replicas.
+type memoryStore struct {
+	mu      sync.Mutex
+	buckets *cache.LRU[string, domain.TokenBucket]
+
+	// now is the clock used to refill buckets; tests replace it.
+	now func() time.Time
+}
+
+// NewMemoryStore creates an in-process store holding the buckets of at most
+// maxKeys callers. A bucket unused for idleTTL is dropped, so the caller
+// starts again with a full burst.
+func NewMemoryStore(maxKeys int, idleTTL time.Duration) contracts.RateLimitStore {
+	return &memoryStore{
+		buckets: cache.New[string, domain.TokenBucket](maxKeys, idleTTL),
+		now:     time.Now,
+	}
+}
+
+// Take implements contracts.RateLimitStore.
+func (s *memoryStore) Take(_ context.Context, key string, limit domain.RateLimit) (domain.RateLimitDecision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bucket, _ := s.buckets.Get(key)
+	bucket, decision := bucket.Take(limit, s.now())
+	s.buckets.Add(key, bucket)
+	return decision, nil
+}
+
+// Close implements contracts.RateLimitStore; there is nothing to release.
+func (s *memoryStore) Close() error {
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	store := NewMemoryStore(10, time.Hour).(*memoryStore)
+	now := time.Unix(1700000000, 0)
+	store.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	limit := domain.RateLimit{Rate: 1, Burst: 2}
+
+	for i := range 2 {
+		decision, err := store.Take(ctx, "alice", limit)
+		if err != nil || !decision.Allowed {
+			t.Fatalf("request %d: allowed %v err %v; want allowed", i+1, decision.Allowed, err)
+		}
+	}
+	decision, _ := store.Take(ctx, "alice", limit)
+	if decision.Allowed {
+		t.Fatal("request over the burst was allowed")
+	}
+	if decision.RetryAfter != time.Second {
+		t.Errorf("RetryAfter = %v; want 1s", decision.RetryAfter)
+	}
+
+	// Keys are limited independently.
+	if decision, _ := store.Take(ctx, "bob", limit); !decision.Allowed {
+		t.Error("another caller was refused")
+	}
+
+	now = now.Add(time.Second)
+	if decision, _ := store.Take(ctx, "alice", limit); !decision.Allowed {
+		t.Error("request after the refill was refused")
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// maxKVUpdateAttempts bounds how often Take re-reads a bucket that another
+// replica updated between the read and the write.
+const maxKVUpdateAttempts = 5
+
+// kvBucket is the part of jetstream.KeyValue the store uses.
+type kvBucket interface {
+	Get(ctx context.Context, key string) (jetstream.KeyValueEntry, error)
+	Create(ctx context.Context, key string, value []byte) (uint64, error)
+	Update(ctx context.Context, key string, value []byte, revision uint64) (uint64, error)
+}
+
+// natsKVStore keeps token buckets in a NATS JetStream key-value bucket shared
+// by all replicas. Writes use the entry revision, so concurrent requests of
+// one caller on different replicas cannot both spend the same token.
+type natsKVStore struct {
+	conn *nats.Conn
+	kv   kvBucket
+
+	// now is the clock used to refill buckets; tests replace it.
+	now func() time.Time
+}
+
+// NewNATSKVStore connects to natsURL and keeps rate-limit state in the
+// key-value bucket named bucket, creating it if it does not exist. Entries of
+// callers idle for stateTTL expire.
+func NewNATSKVStore(ctx context.Context, natsURL, bucket string, stateTTL time.Duration) (contracts.RateLimitStore, error) {
+	slog.Info("Connecting to NATS for rate limit state", "nats_url", natsURL, "bucket", bucket)
+
+	conn, err := nats.Connect(natsURL,
+		nats.MaxReconnects(-1),
+		nats.ReconnectWait(constants.DefaultNATSReconnectWait),
+	)
+	if err != nil {
+		slog.Error("Failed to connect to NATS", "error", err, "nats_url", natsURL)
+		return nil, err
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("jetstream: %w", err)
+	}
+
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:      bucket,
+		Description: "lfx-v2-access-check per-caller rate limit state",
+		History:     1,
+		TTL:         stateTTL,
+		Storage:     jetstream.MemoryStorage,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("rate limit bucket %q: %w", bucket, err)
+	}
+
+	return &natsKVStore{conn: conn, kv: kv, now: time.Now}, nil
+}
+
+// Take implements contracts.RateLimitStore.
+func (s *natsKVStore) Take(ctx context.Context, key string, limit domain.RateLimit) (domain.RateLimitDecision, error) {
+	kvKey := hashKey(key)
+
+	for range maxKVUpdateAttempts {
+		var bucket domain.TokenBucket
+		var revision uint64
+
+		entry, err := s.kv.Get(ctx, kvKey)
+		switch {
+		case err == nil:
+			if err := json.Unmarshal(entry.Value(), &bucket); err != nil {
+				return domain.RateLimitDecision{}, fmt.Errorf("%w: rate limit state: %w", constants.ErrUnexpectedResponse, err)
+			}
+			revision = entry.Revision()
+		case errors.Is(err, jetstream.ErrKeyNotFound):
+		default:
+			return domain.RateLimitDecision{}, err
+		}
+
+		next, decision := bucket.Take(limit, s.now())
+		if !decision.Allowed {
+			// Nothing was spent; the stored state still yields the same refill.
+			return decision, nil
+		}
+
+		data, err := json.Marshal(next)
+		if err != nil {
+			return domain.RateLimitDecision{}, err
+		}
+		if revision == 0 {
+			_, err = s.kv.Create(ctx, kvKey, data)
+		} else {
+			_, err = s.kv.Update(ctx, kvKey, data, revision)
+		}
+		if err == nil {
+			return decision, nil
+		}
+		if !isRevisionConflict(err) {
+			return domain.RateLimitDecision{}, err
+		}
+	}
+
+	return domain.RateLimitDecision{}, constants.ErrRateLimitContention
+}
+
+// Close drains the store's NATS connection.
+func (s *natsKVStore) Close() error {
+	if s.conn != nil {
+		return s.conn.Drain()
+	}
+	return nil
+}
+
+// hashKey maps a rate-limit key to a valid key-value key. Hashing also keeps
+// principals out of the shared bucket.
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// isRevisionConflict reports whether a write failed because another replica
+// wrote the entry first.
+func isRevisionConflict(err error) bool {
+	if errors.Is(err, jetstream.ErrKeyExists) {
+		return true
+	}
+	var apiErr *jetstream.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode == jetstream.JSErrCodeStreamWrongLastSequence
+}
@@ -0,0 +1,165 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type fakeEntry struct {
+	key      string
+	value    []byte
+	revision uint64
+}
+
+func (e *fakeEntry) Bucket() string                  { return "test" }
+func (e *fakeEntry) Key() string                     { return e.key }
+func (e *fakeEntry) Value() []byte                   { return e.value }
+func (e *fakeEntry) Revision() uint64                { return e.revision }
+func (e *fakeEntry) Created() time.Time              { return time.Time{} }
+func (e *fakeEntry) Delta() uint64                   { return 0 }
+func (e *fakeEntry) Operation() jetstream.KeyValueOp { return jetstream.KeyValuePut }
+
+// fakeKV is an in-memory kvBucket that enforces revisions like JetStream.
+type fakeKV struct {
+	entries  map[string]*fakeEntry
+	sequence uint64
+	writes   int
+	getErr   error
+
+	// beforeWrite runs before each write; tests use it to simulate another
+	// replica writing between the read and the write.
+	beforeWrite func(kv *fakeKV, key string)
+}
+
+func newFakeKV() *fakeKV {
+	return &fakeKV{entries: make(map[string]*fakeEntry)}
+}
+
+func (kv *fakeKV) Get(_ context.Context, key string) (jetstream.KeyValueEntry, error) {
+	if kv.getErr != nil {
+		return nil, kv.getErr
+	}
+	entry, ok := kv.entries[key]
+	if !ok {
+		return nil, jetstream.ErrKeyNotFound
+	}
+	return entry, nil
+}
+
+func (kv *fakeKV) Create(ctx context.Context, key string, value []byte) (uint64, error) {
+	if kv.beforeWrite != nil {
+		kv.beforeWrite(kv, key)
+	}
+	if _, ok := kv.entries[key]; ok {
+		return 0, fmt.Errorf("%w: key exists", jetstream.ErrKeyExists)
+	}
+	return kv.put(key, value), nil
+}
+
+func (kv *fakeKV) Update(_ context.Context, key string, value []byte, revision uint64) (uint64, error) {
+	if kv.beforeWrite != nil {
+		kv.beforeWrite(kv, key)
+	}
+	if entry, ok := kv.entries[key]; !ok || entry.revision != revision {
+		return 0, &jetstream.APIError{ErrorCode: jetstream.JSErrCodeStreamWrongLastSequence, Code: 400}
+	}
+	return kv.put(key, value), nil
+}
+
+func (kv *fakeKV) put(key string, value []byte) uint64 {
+	kv.sequence++
+	kv.writes++
+	kv.entries[key] = &fakeEntry{key: key, value: value, revision: kv.sequence}
+	return kv.sequence
+}
+
+func newTestKVStore(kv *fakeKV) *natsKVStore {
+	now := time.Unix(1700000000, 0)
+	return &natsKVStore{kv: kv, now: func() time.Time { return now }}
+}
+
+func TestNATSKVStore_Take(t *testing.T) {
+	kv := newFakeKV()
+	store := newTestKVStore(kv)
+	ctx := context.Background()
+	limit := domain.RateLimit{Rate: 1, Burst: 2}
+
+	for i := range 2 {
+		decision, err := store.Take(ctx, "principal=alice@example.com", limit)
+		if err != nil || !decision.Allowed {
+			t.Fatalf("request %d: allowed %v err %v; want allowed", i+1, decision.Allowed, err)
+		}
+	}
+
+	decision, err := store.Take(ctx, "principal=alice@example.com", limit)
+	if err != nil || decision.Allowed {
+		t.Fatalf("request over the burst: allowed %v err %v; want refused", decision.Allowed, err)
+	}
+	if kv.writes != 2 {
+		t.Errorf("writes = %d; want 2 (refused requests spend nothing)", kv.writes)
+	}
+
+	for key := range kv.entries {
+		if strings.Contains(key, "alice") {
+			t.Errorf("bucket key %q exposes the principal", key)
+		}
+	}
+}
+
+func TestNATSKVStore_Take_RetriesOnConflict(t *testing.T) {
+	tests := []struct {
+		name       string
+		conflicts  int
+		wantErr    error
+		wantWrites int
+	}{
+		{name: "create race", conflicts: 1, wantWrites: 2},
+		{name: "persistent contention", conflicts: maxKVUpdateAttempts, wantErr: constants.ErrRateLimitContention, wantWrites: maxKVUpdateAttempts},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			kv := newFakeKV()
+			conflicts := tc.conflicts
+			kv.beforeWrite = func(kv *fakeKV, key string) {
+				if conflicts > 0 {
+					conflicts--
+					kv.put(key, []byte(`{"tokens":5}`))
+				}
+			}
+			store := newTestKVStore(kv)
+
+			decision, err := store.Take(context.Background(), "alice", domain.RateLimit{Rate: 1, Burst: 10})
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("err = %v; want %v", err, tc.wantErr)
+			}
+			if tc.wantErr == nil && !decision.Allowed {
+				t.Error("request was refused after the retry")
+			}
+			if kv.writes != tc.wantWrites {
+				t.Errorf("writes = %d; want %d", kv.writes, tc.wantWrites)
+			}
+		})
+	}
+}
+
+func TestNATSKVStore_Take_GetError(t *testing.T) {
+	kv := newFakeKV()
+	kv.getErr = errors.New("nats: timeout")
+	store := newTestKVStore(kv)
+
+	if _, err := store.Take(context.Background(), "alice", domain.RateLimit{Rate: 1, Burst: 1}); err == nil {
+		t.Error("expected the store error to be returned")
+	}
+}
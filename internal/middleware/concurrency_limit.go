@@ -0,0 +1,229 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package middleware
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// meter is safe to initialize at package level: otel.Meter() delegates to the
+// MeterProvider registered at call time.
+var meter = otel.Meter("github.com/linuxfoundation/lfx-v2-access-check/internal/middleware")
+
+var (
+	shedRequests, _ = meter.Int64Counter("access_check.http.shed_requests",
+		metric.WithDescription("HTTP requests rejected with 429 by the concurrency limiter, by reason"),
+		metric.WithUnit("{request}"),
+	)
+	concurrencyLimitGauge, _ = meter.Int64Gauge("access_check.http.concurrency_limit",
+		metric.WithDescription("Current adaptive limit on in-flight HTTP requests"),
+		metric.WithUnit("{request}"),
+	)
+	inFlightRequests, _ = meter.Int64UpDownCounter("access_check.http.in_flight_requests",
+		metric.WithDescription("HTTP requests holding a concurrency limiter slot"),
+		metric.WithUnit("{request}"),
+	)
+)
+
+var (
+	shedQueueFull    = metric.WithAttributes(attribute.String("reason", "queue_full"))
+	shedQueueTimeout = metric.WithAttributes(attribute.String("reason", "queue_timeout"))
+)
+
+// limitDecreaseFactor is how much the limit shrinks after a slow request.
+const limitDecreaseFactor = 0.9
+
+// errOverloaded is the 429 error returned to shed requests.
+var errOverloaded = errors.New("too many requests in flight; retry later")
+
+// ConcurrencyLimitConfig configures ConcurrencyLimitMiddleware.
+type ConcurrencyLimitConfig struct {
+	// MinLimit and MaxLimit bound the adaptive limit on in-flight requests.
+	// The limit starts at MaxLimit.
+	MinLimit int
+	MaxLimit int
+	// TargetLatency is the request latency the limit adapts to: slower
+	// requests shrink the limit, faster ones grow it back.
+	TargetLatency time.Duration
+	// QueueTimeout is how long a request waits for a slot before it is shed.
+	// Zero sheds requests as soon as the limit is reached.
+	QueueTimeout time.Duration
+	// MaxQueue caps the requests waiting for a slot.
+	MaxQueue int
+}
+
+// ConcurrencyLimitMiddleware bounds the requests served at once by an
+// adaptive limit (AIMD on latency). A request over the limit waits up to
+// QueueTimeout for a slot; when the queue is full or the wait runs out it is
+// rejected with 429 Too Many Requests and a Retry-After header. Requests for
+// which exempt returns true bypass the limiter.
+func ConcurrencyLimitMiddleware(cfg ConcurrencyLimitConfig, exempt func(*http.Request) bool) func(http.Handler) http.Handler {
+	limiter := newConcurrencyLimiter(cfg)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if exempt != nil && exempt(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			if err := limiter.acquire(ctx); err != nil {
+				if ctx.Err() != nil {
+					// The client went away while queued; nobody reads a response.
+					return
+				}
+				writeOverloaded(ctx, w)
+				return
+			}
+
+			start := time.Now()
+			defer func() { limiter.release(ctx, time.Since(start)) }()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// writeOverloaded writes a Goa-formatted 429 response.
+func writeOverloaded(ctx context.Context, w http.ResponseWriter) {
+	w.Header().Set(constants.RetryAfterHeader, "1")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+
+	svcErr := goa.NewServiceError(errOverloaded, "TooManyRequests", false, true, false)
+	if err := json.NewEncoder(w).Encode(goahttp.NewErrorResponse(ctx, svcErr)); err != nil {
+		slog.ErrorContext(ctx, "Failed to write 429 response", "error", err)
+	}
+}
+
+// concurrencyLimiter hands out slots up to an adaptive limit, queueing
+// requests over it in FIFO order.
+type concurrencyLimiter struct {
+	cfg ConcurrencyLimitConfig
+
+	mu           sync.Mutex
+	limit        float64
+	inFlight     int
+	waiters      *list.List // of chan struct{}
+	lastDecrease time.Time
+
+	// now is the clock used to pace limit decreases; tests replace it.
+	now func() time.Time
+}
+
+func newConcurrencyLimiter(cfg ConcurrencyLimitConfig) *concurrencyLimiter {
+	cfg.MinLimit = max(cfg.MinLimit, 1)
+	cfg.MaxLimit = max(cfg.MaxLimit, cfg.MinLimit)
+	concurrencyLimitGauge.Record(context.Background(), int64(cfg.MaxLimit))
+	return &concurrencyLimiter{
+		cfg:     cfg,
+		limit:   float64(cfg.MaxLimit),
+		waiters: list.New(),
+		now:     time.Now,
+	}
+}
+
+// acquire takes a slot, waiting in the queue if the limit is reached. It
+// returns errOverloaded when the request is shed, or ctx.Err() if the caller
+// gives up first.
+func (l *concurrencyLimiter) acquire(ctx context.Context) error {
+	l.mu.Lock()
+	if l.inFlight < int(l.limit) {
+		l.inFlight++
+		l.mu.Unlock()
+		inFlightRequests.Add(ctx, 1)
+		return nil
+	}
+	if l.cfg.QueueTimeout <= 0 || l.waiters.Len() >= l.cfg.MaxQueue {
+		l.mu.Unlock()
+		shedRequests.Add(ctx, 1, shedQueueFull)
+		return errOverloaded
+	}
+	ready := make(chan struct{})
+	el := l.waiters.PushBack(ready)
+	l.mu.Unlock()
+
+	timer := time.NewTimer(l.cfg.QueueTimeout)
+	defer timer.Stop()
+
+	var err error
+	select {
+	case <-ready:
+		inFlightRequests.Add(ctx, 1)
+		return nil
+	case <-timer.C:
+		err = errOverloaded
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	l.mu.Lock()
+	select {
+	case <-ready:
+		// A slot was handed over just as the wait ended; pass it on.
+		l.releaseLocked()
+	default:
+		l.waiters.Remove(el)
+	}
+	l.mu.Unlock()
+
+	if errors.Is(err, errOverloaded) {
+		shedRequests.Add(ctx, 1, shedQueueTimeout)
+	}
+	return err
+}
+
+// release returns a slot and adapts the limit to the request's latency:
+// additive increase when it met the target, multiplicative decrease (at
+// most once per target latency) when it did not.
+func (l *concurrencyLimiter) release(ctx context.Context, latency time.Duration) {
+	inFlightRequests.Add(ctx, -1)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	previous := int(l.limit)
+	if latency > l.cfg.TargetLatency {
+		if now := l.now(); now.Sub(l.lastDecrease) >= l.cfg.TargetLatency {
+			l.limit = math.Max(l.limit*limitDecreaseFactor, float64(l.cfg.MinLimit))
+			l.lastDecrease = now
+		}
+	} else {
+		l.limit = math.Min(l.limit+1/l.limit, float64(l.cfg.MaxLimit))
+	}
+	if current := int(l.limit); current != previous {
+		concurrencyLimitGauge.Record(ctx, int64(current))
+		slog.DebugContext(ctx, "concurrency limit changed", "from", previous, "to", current, "latency", latency)
+	}
+
+	l.releaseLocked()
+}
+
+// releaseLocked frees a slot, handing it straight to the oldest waiter while
+// the limit allows.
+func (l *concurrencyLimiter) releaseLocked() {
+	if l.inFlight <= int(l.limit) {
+		if front := l.waiters.Front(); front != nil {
+			l.waiters.Remove(front)
+			close(front.Value.(chan struct{}))
+			return
+		}
+	}
+	l.inFlight--
+}
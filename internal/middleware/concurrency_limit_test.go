@@ -0,0 +1,209 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+// blockingHandler holds every request until release is closed, reporting
+// each one on started.
+func blockingHandler(started chan<- struct{}, release <-chan struct{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		started <- struct{}{}
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func TestConcurrencyLimitMiddleware_ShedsOverLimit(t *testing.T) {
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	handler := ConcurrencyLimitMiddleware(ConcurrencyLimitConfig{
+		MinLimit:      1,
+		MaxLimit:      1,
+		TargetLatency: time.Second,
+	}, nil)(blockingHandler(started, release))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/access-check", nil))
+	}()
+	<-started
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/access-check", nil))
+	close(release)
+	<-done
+
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected status 429, got %d", rec.Code)
+	}
+	if got := rec.Header().Get(constants.RetryAfterHeader); got != "1" {
+		t.Errorf("Expected Retry-After '1', got '%s'", got)
+	}
+	var body struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil || body.Name != "TooManyRequests" {
+		t.Errorf("Expected Goa error body named TooManyRequests, got %+v (%v)", body, err)
+	}
+}
+
+func TestConcurrencyLimitMiddleware_ExemptRoutes(t *testing.T) {
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	exempt := func(r *http.Request) bool { return r.URL.Path == "/readyz" }
+	handler := ConcurrencyLimitMiddleware(ConcurrencyLimitConfig{
+		MinLimit:      1,
+		MaxLimit:      1,
+		TargetLatency: time.Second,
+	}, exempt)(blockingHandler(started, release))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/access-check", nil))
+	}()
+	<-started
+
+	rec := httptest.NewRecorder()
+	go func() { <-started; close(release) }()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/readyz", nil))
+	<-done
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected exempt route to be served, got %d", rec.Code)
+	}
+}
+
+func TestConcurrencyLimiter_Queue(t *testing.T) {
+	tests := []struct {
+		name        string
+		releaseWait time.Duration
+		wantErr     error
+	}{
+		{"slot freed while queued", 0, nil},
+		{"queue timeout", time.Second, errOverloaded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newConcurrencyLimiter(ConcurrencyLimitConfig{
+				MinLimit:      1,
+				MaxLimit:      1,
+				TargetLatency: time.Second,
+				QueueTimeout:  50 * time.Millisecond,
+				MaxQueue:      1,
+			})
+			ctx := context.Background()
+			if err := l.acquire(ctx); err != nil {
+				t.Fatalf("first acquire: %v", err)
+			}
+
+			go func() {
+				time.Sleep(tt.releaseWait)
+				l.release(ctx, time.Millisecond)
+			}()
+
+			if err := l.acquire(ctx); !errors.Is(err, tt.wantErr) {
+				t.Errorf("queued acquire err = %v; want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConcurrencyLimiter_QueueFull(t *testing.T) {
+	l := newConcurrencyLimiter(ConcurrencyLimitConfig{
+		MinLimit:      1,
+		MaxLimit:      1,
+		TargetLatency: time.Second,
+		QueueTimeout:  time.Second,
+		MaxQueue:      1,
+	})
+	ctx := context.Background()
+	if err := l.acquire(ctx); err != nil {
+		t.Fatalf("first acquire: %v", err)
+	}
+
+	queued := make(chan error)
+	go func() { queued <- l.acquire(ctx) }()
+	for {
+		l.mu.Lock()
+		n := l.waiters.Len()
+		l.mu.Unlock()
+		if n == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if err := l.acquire(ctx); !errors.Is(err, errOverloaded) {
+		t.Errorf("acquire with a full queue: err = %v; want errOverloaded", err)
+	}
+
+	l.release(ctx, time.Millisecond)
+	if err := <-queued; err != nil {
+		t.Errorf("queued acquire: err = %v; want the released slot", err)
+	}
+}
+
+func TestConcurrencyLimiter_AdaptsToLatency(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := newConcurrencyLimiter(ConcurrencyLimitConfig{
+		MinLimit:      5,
+		MaxLimit:      100,
+		TargetLatency: time.Second,
+	})
+	l.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	slow := func() {
+		if err := l.acquire(ctx); err != nil {
+			t.Fatalf("acquire: %v", err)
+		}
+		l.release(ctx, 2*time.Second)
+	}
+
+	slow()
+	if l.limit != 90 {
+		t.Fatalf("limit after slow request = %v; want 90", l.limit)
+	}
+
+	// A second slow request within the same target window does not shrink
+	// the limit again.
+	slow()
+	if l.limit != 90 {
+		t.Errorf("limit after second slow request in window = %v; want 90", l.limit)
+	}
+
+	for i := 0; i < 50; i++ {
+		now = now.Add(time.Second)
+		slow()
+	}
+	if l.limit != 5 {
+		t.Errorf("limit after sustained slow requests = %v; want the minimum 5", l.limit)
+	}
+
+	for i := 0; i < 100; i++ {
+		if err := l.acquire(ctx); err != nil {
+			t.Fatalf("acquire: %v", err)
+		}
+		l.release(ctx, time.Millisecond)
+	}
+	if l.limit <= 5 {
+		t.Errorf("limit after fast requests = %v; want it to grow", l.limit)
+	}
+	if l.inFlight != 0 {
+		t.Errorf("inFlight = %d; want 0", l.inFlight)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+// grpcTimeoutUnits maps the unit suffix of a grpc-timeout value to its size.
+var grpcTimeoutUnits = map[byte]time.Duration{
+	'H': time.Hour,
+	'M': time.Minute,
+	'S': time.Second,
+	'm': time.Millisecond,
+	'u': time.Microsecond,
+	'n': time.Nanosecond,
+}
+
+// RequestTimeoutMiddleware sets a deadline on the request context. Callers
+// choose it with the X-Request-Timeout or grpc-timeout header; it is capped
+// at maxTimeout, which also applies when neither header is set or the value
+// cannot be parsed. A maxTimeout of zero or less adds no deadline.
+func RequestTimeoutMiddleware(maxTimeout time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if maxTimeout <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			timeout := maxTimeout
+			if requested, ok := requestedTimeout(r); ok {
+				timeout = min(requested, maxTimeout)
+			}
+
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// requestedTimeout returns the timeout asked for by the request headers.
+// X-Request-Timeout wins when both are set.
+func requestedTimeout(r *http.Request) (time.Duration, bool) {
+	if value := r.Header.Get(constants.RequestTimeoutHeader); value != "" {
+		timeout, ok := parseRequestTimeout(value)
+		if !ok {
+			slog.DebugContext(r.Context(), "ignoring invalid request timeout header",
+				"header", constants.RequestTimeoutHeader, "value", value)
+		}
+		return timeout, ok
+	}
+	if value := r.Header.Get(constants.GRPCTimeoutHeader); value != "" {
+		timeout, ok := parseGRPCTimeout(value)
+		if !ok {
+			slog.DebugContext(r.Context(), "ignoring invalid request timeout header",
+				"header", constants.GRPCTimeoutHeader, "value", value)
+		}
+		return timeout, ok
+	}
+	return 0, false
+}
+
+// parseRequestTimeout parses a Go duration ("500ms") or whole seconds ("5").
+func parseRequestTimeout(value string) (time.Duration, bool) {
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		seconds, convErr := strconv.Atoi(value)
+		if convErr != nil {
+			return 0, false
+		}
+		timeout = time.Duration(seconds) * time.Second
+	}
+	return timeout, timeout > 0
+}
+
+// parseGRPCTimeout parses a grpc-timeout value: up to eight digits followed
+// by a unit (H, M, S, m, u or n).
+func parseGRPCTimeout(value string) (time.Duration, bool) {
+	if len(value) < 2 || len(value) > 9 {
+		return 0, false
+	}
+	unit, ok := grpcTimeoutUnits[value[len(value)-1]]
+	if !ok {
+		return 0, false
+	}
+	digits := value[:len(value)-1]
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+	}
+	n, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	if n > math.MaxInt64/int64(unit) {
+		return time.Duration(math.MaxInt64), true
+	}
+	return time.Duration(n) * unit, true
+}
@@ -0,0 +1,93 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+func TestRequestTimeoutMiddleware(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		value   string
+		max     time.Duration
+		want    time.Duration
+		noLimit bool
+	}{
+		{name: "no header uses max", max: 15 * time.Second, want: 15 * time.Second},
+		{name: "duration header", header: constants.RequestTimeoutHeader, value: "500ms", max: 15 * time.Second, want: 500 * time.Millisecond},
+		{name: "seconds header", header: constants.RequestTimeoutHeader, value: "2", max: 15 * time.Second, want: 2 * time.Second},
+		{name: "header capped at max", header: constants.RequestTimeoutHeader, value: "1m", max: 15 * time.Second, want: 15 * time.Second},
+		{name: "invalid header uses max", header: constants.RequestTimeoutHeader, value: "soon", max: 15 * time.Second, want: 15 * time.Second},
+		{name: "negative header uses max", header: constants.RequestTimeoutHeader, value: "-1s", max: 15 * time.Second, want: 15 * time.Second},
+		{name: "grpc-timeout header", header: constants.GRPCTimeoutHeader, value: "250m", max: 15 * time.Second, want: 250 * time.Millisecond},
+		{name: "grpc-timeout capped at max", header: constants.GRPCTimeoutHeader, value: "99999999H", max: 15 * time.Second, want: 15 * time.Second},
+		{name: "zero max adds no deadline", header: constants.RequestTimeoutHeader, value: "1s", noLimit: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deadline time.Time
+			var hasDeadline bool
+			var remaining time.Duration
+			testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				deadline, hasDeadline = r.Context().Deadline()
+				remaining = time.Until(deadline)
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest("GET", "/test", nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+			RequestTimeoutMiddleware(tt.max)(testHandler).ServeHTTP(httptest.NewRecorder(), req)
+
+			if tt.noLimit {
+				if hasDeadline {
+					t.Errorf("Expected no deadline, got %v", deadline)
+				}
+				return
+			}
+			if !hasDeadline {
+				t.Fatal("Expected the request context to have a deadline")
+			}
+			if remaining < tt.want-100*time.Millisecond || remaining > tt.want {
+				t.Errorf("Expected deadline about %v from now, got %v", tt.want, remaining)
+			}
+		})
+	}
+}
+
+func TestParseGRPCTimeout(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"1H", time.Hour, true},
+		{"2M", 2 * time.Minute, true},
+		{"3S", 3 * time.Second, true},
+		{"500m", 500 * time.Millisecond, true},
+		{"10u", 10 * time.Microsecond, true},
+		{"100n", 100 * time.Nanosecond, true},
+		{"5", 0, false},
+		{"S", 0, false},
+		{"0S", 0, false},
+		{"1.5S", 0, false},
+		{"123456789S", 0, false},
+		{"5s", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseGRPCTimeout(tt.value)
+		if ok != tt.wantOK || got != tt.want {
+			t.Errorf("parseGRPCTimeout(%q) = %v, %v; want %v, %v", tt.value, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
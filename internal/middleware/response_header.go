@@ -0,0 +1,32 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+// ResponseHeaderMiddleware makes the response headers reachable from the
+// request context, so service methods can set headers that the Goa design
+// cannot express (see SetResponseHeader).
+func ResponseHeaderMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), constants.ResponseHeaderContextKey, w.Header())
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// SetResponseHeader sets a header on the response of the request that ctx
+// belongs to. It must be called before the response is written, and is a
+// no-op when ResponseHeaderMiddleware is not installed.
+func SetResponseHeader(ctx context.Context, key, value string) {
+	if header, ok := ctx.Value(constants.ResponseHeaderContextKey).(http.Header); ok {
+		header.Set(key, value)
+	}
+}
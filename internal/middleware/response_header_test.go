@@ -0,0 +1,32 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+func TestResponseHeaderMiddleware_SetResponseHeader(t *testing.T) {
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		SetResponseHeader(r.Context(), constants.RetryAfterHeader, "7")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	rec := httptest.NewRecorder()
+	ResponseHeaderMiddleware()(testHandler).ServeHTTP(rec, req)
+
+	if got := rec.Header().Get(constants.RetryAfterHeader); got != "7" {
+		t.Errorf("Expected Retry-After header '7', got '%s'", got)
+	}
+}
+
+func TestSetResponseHeader_WithoutMiddleware(t *testing.T) {
+	// Must not panic when the middleware is not installed.
+	SetResponseHeader(context.Background(), constants.RetryAfterHeader, "7")
+}
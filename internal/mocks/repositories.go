@@ -42,8 +42,9 @@ func (m *MockAuthRepository) HealthCheck(ctx context.Context) error {
 
 // MockMessagingRepository provides a mock implementation of MessagingRepository
 type MockMessagingRepository struct {
-	RequestFunc func(ctx context.Context, subject string, data []byte, timeout time.Duration) ([]byte, error)
-	CloseFunc   func() error
+	RequestFunc   func(ctx context.Context, subject string, data []byte, timeout time.Duration) ([]byte, error)
+	SubscribeFunc func(subject string, handler contracts.MessageHandler) error
+	CloseFunc     func() error
 }
 
 // NewMockMessagingRepository creates a new mock messaging repository
@@ -59,6 +60,14 @@ func (m *MockMessagingRepository) Request(ctx context.Context, subject string, d
 	return []byte("project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#auditor@user:auth0|alice\ttrue"), nil
 }
 
+// Subscribe mocks NATS subscription
+func (m *MockMessagingRepository) Subscribe(subject string, handler contracts.MessageHandler) error {
+	if m.SubscribeFunc != nil {
+		return m.SubscribeFunc(subject, handler)
+	}
+	return nil
+}
+
 // Close mocks connection closing
 func (m *MockMessagingRepository) Close() error {
 	if m.CloseFunc != nil {
@@ -7,11 +7,67 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/cache"
 	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/sync/errgroup"
+)
+
+// meter is safe to initialize at package level for the same reason as the
+// messaging tracer: otel.Meter() delegates to the MeterProvider registered at
+// call time.
+var meter = otel.Meter("github.com/linuxfoundation/lfx-v2-access-check/internal/service")
+
+// decisionCacheLookups counts decision cache lookups by result ("hit" or "miss").
+var decisionCacheLookups, _ = meter.Int64Counter("access_check.decision_cache.lookups",
+	metric.WithDescription("Access-check decision cache lookups by result"),
+	metric.WithUnit("{lookup}"),
+)
+
+// grantsCacheLookups counts read-tuples page cache lookups by result.
+var grantsCacheLookups, _ = meter.Int64Counter("access_check.grants_cache.lookups",
+	metric.WithDescription("My-grants page cache lookups by result"),
+	metric.WithUnit("{lookup}"),
+)
+
+// cacheInvalidations counts cache entries evicted by tuple change events, by
+// cache ("decision" or "grants").
+var cacheInvalidations, _ = meter.Int64Counter("access_check.cache.invalidations",
+	metric.WithDescription("Cache entries evicted by fga-sync tuple change events"),
+	metric.WithUnit("{entry}"),
+)
+
+// degradedDecisions counts cache misses met while fga-sync was unavailable,
+// each once, by outcome: "stale" (an expired decision was usable),
+// "fail_closed" (denied) or "unavailable" (no answer, the call failed).
+var degradedDecisions, _ = meter.Int64Counter("access_check.degraded.decisions",
+	metric.WithDescription("Access-check decisions answered in degraded mode, by outcome"),
+	metric.WithUnit("{decision}"),
+)
+
+var (
+	degradedStale       = metric.WithAttributes(attribute.String("outcome", "stale"))
+	degradedFailClosed  = metric.WithAttributes(attribute.String("outcome", "fail_closed"))
+	degradedUnavailable = metric.WithAttributes(attribute.String("outcome", "unavailable"))
+)
+
+var (
+	cacheHit  = metric.WithAttributes(attribute.String("result", "hit"))
+	cacheMiss = metric.WithAttributes(attribute.String("result", "miss"))
+
+	decisionCacheAttr = metric.WithAttributes(attribute.String("cache", "decision"))
+	grantsCacheAttr   = metric.WithAttributes(attribute.String("cache", "grants"))
 )
 
 // AccessCheckClient handles the NATS protocol for access checking and tuple reading.
@@ -19,11 +75,47 @@ import (
 // of HTTP, Goa types, or authentication.
 type AccessCheckClient struct {
 	messagingRepo contracts.MessagingRepository
+
+	// decisions caches access-check results keyed by tuple, which includes the
+	// principal. Nil disables caching.
+	decisions *cache.LRU[domain.Tuple, bool]
+
+	// grants caches ReadTuples pages. Nil disables caching.
+	grants *cache.LRU[grantsCacheKey, TuplesPage]
+
+	// batcher merges plaintext checks of concurrent callers into shared
+	// messages. Nil sends every call's checks on their own.
+	batcher *checkBatcher
+
+	// chunkSize caps the tuples per access-check message; chunkParallelism
+	// caps the chunks of one call in flight at once.
+	chunkSize        int
+	chunkParallelism int
+
+	// maxStaleness is how old a cached decision may be and still be served
+	// while fga-sync is unavailable; zero disables degraded mode. failClosed
+	// denies uncached checks in degraded mode instead of failing the call.
+	maxStaleness time.Duration
+	failClosed   bool
+}
+
+// grantsCacheKey identifies one ReadTuples page. objectTypes holds the
+// requested types joined by commas, so the key stays comparable.
+type grantsCacheKey struct {
+	user              string
+	objectTypes       string
+	relation          string
+	pageSize          int
+	continuationToken string
 }
 
 // NewAccessCheckClient creates a new AccessCheckClient backed by the given messaging repository.
 func NewAccessCheckClient(messagingRepo contracts.MessagingRepository) *AccessCheckClient {
-	return &AccessCheckClient{messagingRepo: messagingRepo}
+	return &AccessCheckClient{
+		messagingRepo:    messagingRepo,
+		chunkSize:        constants.DefaultCheckChunkSize,
+		chunkParallelism: constants.DefaultCheckChunkParallelism,
+	}
 }
 
 // HealthCheck checks the messaging repository health, returning ErrMessagingRepoNotInit
@@ -35,107 +127,683 @@ func (c *AccessCheckClient) HealthCheck(ctx context.Context) error {
 	return c.messagingRepo.HealthCheck(ctx)
 }
 
-// CheckAccess sends resource-action pairs to fga-sync via NATS and returns the
-// newline-delimited result lines.
+// EnableDecisionCache caches up to size access-check decisions for ttl each.
+// A non-positive size or ttl disables the cache.
+func (c *AccessCheckClient) EnableDecisionCache(size int, ttl time.Duration) {
+	if size <= 0 || ttl <= 0 {
+		c.decisions = nil
+		return
+	}
+	c.decisions = cache.New[domain.Tuple, bool](size, ttl)
+	c.decisions.KeepStale(c.maxStaleness)
+}
+
+// EnableDegradedMode serves cached decisions up to maxStaleness old when
+// fga-sync is unavailable, marking them stale. Checks with no such decision
+// are denied when failClosed is set; otherwise the call fails as usual. It
+// needs the decision cache; a non-positive maxStaleness disables it.
+func (c *AccessCheckClient) EnableDegradedMode(maxStaleness time.Duration, failClosed bool) {
+	c.maxStaleness = max(maxStaleness, 0)
+	c.failClosed = failClosed
+	if c.decisions != nil {
+		c.decisions.KeepStale(c.maxStaleness)
+	}
+}
+
+// EnableGrantsCache caches up to size ReadTuples pages for ttl each. A
+// non-positive size or ttl disables the cache.
+func (c *AccessCheckClient) EnableGrantsCache(size int, ttl time.Duration) {
+	if size <= 0 || ttl <= 0 {
+		c.grants = nil
+		return
+	}
+	c.grants = cache.New[grantsCacheKey, TuplesPage](size, ttl)
+}
+
+// SetChunking caps the tuples sent in one access-check message at chunkSize
+// and the chunks of one call in flight at once at parallelism. A non-positive
+// value keeps the current setting.
+func (c *AccessCheckClient) SetChunking(chunkSize int, parallelism int) {
+	if chunkSize > 0 {
+		c.chunkSize = chunkSize
+	}
+	if parallelism > 0 {
+		c.chunkParallelism = parallelism
+	}
+}
+
+// EnableBatching merges the plaintext checks of concurrent callers into
+// shared access-check messages of at most maxSize tuples, each sent window
+// after its first check arrived. Each message gets the latest deadline among
+// its callers, capped at maxTimeout. A non-positive window or maxSize disables
+// batching; a non-positive maxTimeout means DefaultRequestTimeoutMax.
+func (c *AccessCheckClient) EnableBatching(window time.Duration, maxSize int, maxTimeout time.Duration) {
+	if window <= 0 || maxSize <= 0 {
+		c.batcher = nil
+		return
+	}
+	if maxTimeout <= 0 {
+		maxTimeout = constants.DefaultRequestTimeoutMax
+	}
+	c.batcher = newCheckBatcher(c.sendChecks, window, maxSize, maxTimeout)
+}
+
+// SubscribeTupleChanges listens on subject for fga-sync tuple change events
+// and evicts the cached decisions and grants they affect.
+func (c *AccessCheckClient) SubscribeTupleChanges(subject string) error {
+	if c.messagingRepo == nil {
+		return constants.ErrMessagingRepoNotInit
+	}
+	return c.messagingRepo.Subscribe(subject, c.handleTupleChange)
+}
+
+// handleTupleChange evicts the cache entries affected by one tuple change
+// event. An event that cannot be parsed purges both caches, since the change
+// it describes is unknown.
+func (c *AccessCheckClient) handleTupleChange(ctx context.Context, subject string, data []byte) {
+	tuples, err := parseTupleChange(data)
+	if err != nil {
+		slog.WarnContext(ctx, "purging caches after unreadable tuple change event", "error", err, "subject", subject)
+		c.purgeCaches(ctx)
+		return
+	}
+	c.InvalidateTuples(ctx, tuples)
+}
+
+// InvalidateTuples evicts the cache entries that written or deleted tuples may
+// have made stale.
 //
-// Important: result order is NOT guaranteed. fga-sync returns cached hits
-// before fresh OpenFGA checks, so callers must match each line by its
-// "object#relation@user" prefix, not by position. See
-// docs/access-check-contract.md for the full unordered-response caveat.
+// Grants are direct tuples, so only the changed user's pages are evicted. A
+// change for a single principal ("user:auth0|alice") can only alter that
+// principal's decisions, on the object or on anything inheriting from it, so
+// all of the principal's decisions are evicted. Any other change (a userset,
+// the "user:*" wildcard, or a parent object) can alter decisions of arbitrary
+// users on arbitrary descendant objects, so every decision is purged.
+func (c *AccessCheckClient) InvalidateTuples(ctx context.Context, tuples []domain.Tuple) {
+	users := make(map[string]struct{}, len(tuples))
+	purgeDecisions := false
+	for _, t := range tuples {
+		users[t.User] = struct{}{}
+		if !domain.IsPrincipal(t.User) {
+			purgeDecisions = true
+		}
+	}
+
+	var decisions, grants int
+	if c.decisions != nil {
+		if purgeDecisions {
+			decisions = c.decisions.Purge()
+		} else {
+			decisions = c.decisions.RemoveFunc(func(t domain.Tuple, _ bool) bool {
+				_, changed := users[t.User]
+				return changed
+			})
+		}
+	}
+	if c.grants != nil {
+		grants = c.grants.RemoveFunc(func(key grantsCacheKey, _ TuplesPage) bool {
+			_, changed := users[key.user]
+			return changed
+		})
+	}
+	c.recordInvalidations(ctx, decisions, grants)
+	slog.DebugContext(ctx, "evicted cache entries for tuple changes",
+		"tuples_count", len(tuples),
+		"decisions_evicted", decisions,
+		"grants_evicted", grants,
+	)
+}
+
+// purgeCaches evicts every cached decision and grants page.
+func (c *AccessCheckClient) purgeCaches(ctx context.Context) {
+	var decisions, grants int
+	if c.decisions != nil {
+		decisions = c.decisions.Purge()
+	}
+	if c.grants != nil {
+		grants = c.grants.Purge()
+	}
+	c.recordInvalidations(ctx, decisions, grants)
+}
+
+func (c *AccessCheckClient) recordInvalidations(ctx context.Context, decisions, grants int) {
+	cacheInvalidations.Add(ctx, int64(decisions), decisionCacheAttr)
+	cacheInvalidations.Add(ctx, int64(grants), grantsCacheAttr)
+}
+
+// parseTupleChange decodes a fga-sync tuple change event into its tuples.
+func parseTupleChange(data []byte) ([]domain.Tuple, error) {
+	var event tupleChangeEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, fmt.Errorf("failed to parse tuple change event: %w", err)
+	}
+	tuples := make([]domain.Tuple, 0, len(event.Tuples))
+	for _, tuple := range event.Tuples {
+		t, err := domain.ParseTuple(tuple)
+		if err != nil {
+			return nil, err
+		}
+		tuples = append(tuples, t)
+	}
+	return tuples, nil
+}
+
+// CheckAccess sends tuples to fga-sync via NATS and returns one result per
+// tuple, in request order.
+//
+// fga-sync replies unordered (cached hits before fresh OpenFGA checks), so the
+// reply is matched back to the requests by tuple: results[i] always answers
+// checks[i], including duplicates. A reply that omits a requested tuple or
+// contains one that was never asked for fails with ErrUnexpectedResponse.
 //
-// principal must be non-empty; empty resource strings are skipped.
-func (c *AccessCheckClient) CheckAccess(ctx context.Context, principal string, resources []string) ([]string, error) {
-	if principal == "" {
-		return nil, constants.ErrPrincipalRequired
+// Every tuple must pass domain.Tuple.Validate; nothing is sent when any entry
+// is invalid, and the returned ErrInvalidAccessRequest names the index of each
+// offending entry.
+func (c *AccessCheckClient) CheckAccess(ctx context.Context, checks []domain.Tuple) ([]domain.CheckResult, error) {
+	return c.CheckAccessWithContext(ctx, checks, domain.CheckContext{})
+}
+
+// CheckAccessWithContext is CheckAccess with contextual tuples and condition
+// context applied to every check. A non-zero check context switches the
+// payload from plaintext lines to the versioned JSON encoding built by
+// buildContextualMessage; the reply format is the same for both.
+//
+// When the decision cache is enabled, cached decisions answer their tuples
+// directly and only the misses are sent to fga-sync. Checks with a non-zero
+// check context bypass the cache, since their answer depends on the context.
+//
+// Contextual tuples must not state a check or involve its user (see
+// domain.CheckContext.Validate), and every result of a call with contextual
+// tuples is marked Contextual.
+func (c *AccessCheckClient) CheckAccessWithContext(ctx context.Context, checks []domain.Tuple, checkCtx domain.CheckContext) ([]domain.CheckResult, error) {
+	if len(checks) == 0 {
+		return []domain.CheckResult{}, nil
+	}
+
+	if err := validateChecks(checks); err != nil {
+		return nil, err
+	}
+	if err := checkCtx.Validate(checks); err != nil {
+		return nil, fmt.Errorf("%w: %v", constants.ErrInvalidAccessRequest, err)
+	}
+
+	if len(checkCtx.ContextualTuples) > 0 {
+		results, err := c.requestChecks(ctx, checks, checkCtx)
+		for i := range results {
+			results[i].Contextual = true
+		}
+		return results, err
+	}
+	if c.decisions == nil || !checkCtx.IsZero() {
+		return c.requestChecks(ctx, checks, checkCtx)
+	}
+	return c.checkCached(ctx, checks)
+}
+
+// checkCached answers checks from the decision cache, requests the misses
+// from fga-sync in one call, caches their results and merges both back into
+// request order.
+func (c *AccessCheckClient) checkCached(ctx context.Context, checks []domain.Tuple) ([]domain.CheckResult, error) {
+	unique := uniqueTuples(checks)
+	decided := make(map[domain.Tuple]bool, len(unique))
+	misses := make([]domain.Tuple, 0, len(unique))
+	for _, check := range unique {
+		if allowed, ok := c.decisions.Get(check); ok {
+			decided[check] = allowed
+			continue
+		}
+		misses = append(misses, check)
+	}
+	decisionCacheLookups.Add(ctx, int64(len(decided)), cacheHit)
+	decisionCacheLookups.Add(ctx, int64(len(misses)), cacheMiss)
+
+	if len(misses) > 0 {
+		// A tuple change landing while the request is in flight evicts
+		// nothing yet cached; the generation keeps its reply out too.
+		gen := c.decisions.Generation()
+		results, err := c.requestChecks(ctx, misses, domain.CheckContext{})
+		if err != nil {
+			// A caller that gave up or ran out of its own deadline says
+			// nothing about fga-sync, so it is not served stale decisions.
+			if c.maxStaleness <= 0 || ctx.Err() != nil || !isUnavailable(err) {
+				return nil, err
+			}
+			return c.checkDegraded(ctx, checks, decided, misses, err)
+		}
+		for _, result := range results {
+			c.decisions.AddIfGeneration(result.Tuple, result.Allowed, gen)
+			decided[result.Tuple] = result.Allowed
+		}
+	}
+
+	return inRequestOrder(checks, decided), nil
+}
+
+// checkDegraded answers misses from expired cache entries younger than
+// maxStaleness after fga-sync failed with err, or denies them when failing
+// closed. decided holds the fresh hits. Results answered either way are
+// marked stale; if any miss cannot be answered, err is returned.
+func (c *AccessCheckClient) checkDegraded(ctx context.Context, checks []domain.Tuple, decided map[domain.Tuple]bool, misses []domain.Tuple, err error) ([]domain.CheckResult, error) {
+	stale := make(map[domain.Tuple]bool, len(misses))
+	var staleHits, closed, unanswered int
+	for _, miss := range misses {
+		stale[miss] = true
+		switch allowed, age, ok := c.decisions.GetStale(miss); {
+		case ok && age < c.maxStaleness:
+			decided[miss] = allowed
+			staleHits++
+		case c.failClosed:
+			decided[miss] = false
+			closed++
+		default:
+			unanswered++
+		}
+	}
+
+	degradedDecisions.Add(ctx, int64(staleHits), degradedStale)
+	degradedDecisions.Add(ctx, int64(closed), degradedFailClosed)
+	if unanswered > 0 {
+		degradedDecisions.Add(ctx, int64(unanswered), degradedUnavailable)
+		return nil, err
+	}
+	slog.WarnContext(ctx, "fga-sync unavailable, serving degraded access-check decisions",
+		"error", err, "stale", staleHits, "fail_closed", closed)
+
+	results := inRequestOrder(checks, decided)
+	for i := range results {
+		results[i].Stale = stale[results[i].Tuple]
+	}
+	return results, nil
+}
+
+// isUnavailable reports whether err means fga-sync could not be reached or
+// did not reply in time, as opposed to a rejected or malformed exchange.
+func isUnavailable(err error) bool {
+	return !errors.Is(err, constants.ErrInvalidAccessRequest) &&
+		!errors.Is(err, constants.ErrPrincipalRequired) &&
+		!errors.Is(err, constants.ErrInvalidPrincipal) &&
+		!errors.Is(err, constants.ErrUnexpectedResponse)
+}
+
+// inRequestOrder returns one result per check, in order, from decisions keyed
+// by tuple.
+func inRequestOrder(checks []domain.Tuple, decided map[domain.Tuple]bool) []domain.CheckResult {
+	ordered := make([]domain.CheckResult, 0, len(checks))
+	for _, check := range checks {
+		ordered = append(ordered, domain.CheckResult{Tuple: check, Allowed: decided[check]})
+	}
+	return ordered
+}
+
+// requestChecks sends validated checks to fga-sync and returns one result per
+// check, in request order. Plaintext checks go through the batcher when
+// batching is enabled.
+func (c *AccessCheckClient) requestChecks(ctx context.Context, checks []domain.Tuple, checkCtx domain.CheckContext) ([]domain.CheckResult, error) {
+	if !checkCtx.IsZero() {
+		return c.sendContextualChecks(ctx, checks, checkCtx)
+	}
+	if c.batcher == nil {
+		return c.sendChecks(ctx, checks)
+	}
+
+	decided, err := c.batcher.check(ctx, uniqueTuples(checks))
+	if err != nil {
+		return nil, err
+	}
+	return inRequestOrder(checks, decided), nil
+}
+
+// sendChecks sends checks as plaintext access-check messages.
+func (c *AccessCheckClient) sendChecks(ctx context.Context, checks []domain.Tuple) ([]domain.CheckResult, error) {
+	return c.sendChunked(ctx, checks, func(ctx context.Context, chunk []domain.Tuple) ([]domain.CheckResult, error) {
+		return c.sendCheckMessage(ctx, chunk, []byte(c.buildMessage(chunk)))
+	})
+}
+
+// sendContextualChecks sends checks with their check context as versioned
+// JSON access-check messages. Every chunk carries the full check context.
+func (c *AccessCheckClient) sendContextualChecks(ctx context.Context, checks []domain.Tuple, checkCtx domain.CheckContext) ([]domain.CheckResult, error) {
+	return c.sendChunked(ctx, checks, func(ctx context.Context, chunk []domain.Tuple) ([]domain.CheckResult, error) {
+		message, err := c.buildContextualMessage(chunk, checkCtx)
+		if err != nil {
+			return nil, err
+		}
+		return c.sendCheckMessage(ctx, chunk, message)
+	})
+}
+
+// sendChunked splits the unique checks into chunks of at most chunkSize
+// tuples, sends them through send with at most chunkParallelism in flight, and
+// merges the results back into request order. The first failing chunk cancels
+// the others and fails the whole call, so callers never see partial results.
+func (c *AccessCheckClient) sendChunked(ctx context.Context, checks []domain.Tuple, send sendChecksFunc) ([]domain.CheckResult, error) {
+	unique := uniqueTuples(checks)
+	if len(unique) <= c.chunkSize {
+		return send(ctx, checks)
+	}
+
+	chunks := slices.Collect(slices.Chunk(unique, c.chunkSize))
+	chunkResults := make([][]domain.CheckResult, len(chunks))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(c.chunkParallelism)
+	for i, chunk := range chunks {
+		g.Go(func() error {
+			results, err := send(gctx, chunk)
+			chunkResults[i] = results
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
 	}
 
-	if len(resources) == 0 {
-		return []string{}, nil
+	decided := make(map[domain.Tuple]bool, len(unique))
+	for _, results := range chunkResults {
+		for _, result := range results {
+			decided[result.Tuple] = result.Allowed
+		}
 	}
+	return inRequestOrder(checks, decided), nil
+}
 
-	message := c.buildMessage(principal, resources)
-	if message == "" {
-		return []string{}, nil
+// requestTimeout returns the NATS timeout for a request made on behalf of ctx:
+// the time left before its deadline, or DefaultNATSTimeout when it has none.
+func requestTimeout(ctx context.Context) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		return time.Until(deadline)
 	}
+	return constants.DefaultNATSTimeout
+}
 
-	responseData, err := c.messagingRepo.Request(ctx, constants.AccessCheckSubject, []byte(message), constants.DefaultNATSTimeout)
+// sendCheckMessage sends an access-check message encoding checks and matches
+// the reply back to them.
+func (c *AccessCheckClient) sendCheckMessage(ctx context.Context, checks []domain.Tuple, message []byte) ([]domain.CheckResult, error) {
+	responseData, err := c.messagingRepo.Request(ctx, constants.AccessCheckSubject, message, requestTimeout(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("NATS request to subject %s failed: %w", constants.AccessCheckSubject, err)
 	}
 
-	return c.parseResponse(responseData)
+	results, err := c.parseResponse(responseData)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.orderResults(checks, results)
+}
+
+// ReadTuplesQuery selects the page of direct tuples returned by ReadTuples.
+type ReadTuplesQuery struct {
+	User        string
+	ObjectTypes []string
+	// Relation, when set, restricts the reply to tuples of that relation.
+	Relation string
+
+	// PageSize caps the number of tuples in the reply; zero leaves the page
+	// size to fga-sync.
+	PageSize int
+	// ContinuationToken resumes a previous read; empty starts from the first page.
+	ContinuationToken string
+}
+
+// TuplesPage is one page of direct tuples. An empty ContinuationToken means
+// there are no further pages.
+type TuplesPage struct {
+	Tuples            []domain.Tuple
+	ContinuationToken string
+}
+
+// ReadTuples fetches one page of the direct OpenFGA tuples for a user via NATS.
+// The continuation token is opaque to this service and passed through to and
+// from fga-sync unchanged.
+//
+// All object types go out in a single read_tuples request. A single type is
+// sent as object_type, so responders that predate object_types keep working.
+// Tuples outside the requested types or relation are dropped from the reply,
+// so a page may hold fewer than PageSize tuples. A responder that predates
+// paging ignores PageSize; its reply is returned whole, so a page may also
+// hold more.
+//
+// When the grants cache is enabled, pages are cached per query and evicted by
+// tuple change events for their user (see InvalidateTuples). A page read
+// while an event was handled is not cached, since it may predate the change.
+func (c *AccessCheckClient) ReadTuples(ctx context.Context, query ReadTuplesQuery) (TuplesPage, error) {
+	if c.grants == nil {
+		return c.readTuples(ctx, query)
+	}
+
+	key := grantsCacheKey{
+		user:              query.User,
+		objectTypes:       strings.Join(query.ObjectTypes, ","),
+		relation:          query.Relation,
+		pageSize:          query.PageSize,
+		continuationToken: query.ContinuationToken,
+	}
+	if page, ok := c.grants.Get(key); ok {
+		grantsCacheLookups.Add(ctx, 1, cacheHit)
+		page.Tuples = slices.Clone(page.Tuples)
+		return page, nil
+	}
+	grantsCacheLookups.Add(ctx, 1, cacheMiss)
+
+	gen := c.grants.Generation()
+	page, err := c.readTuples(ctx, query)
+	if err != nil {
+		return TuplesPage{}, err
+	}
+	c.grants.AddIfGeneration(key, TuplesPage{Tuples: slices.Clone(page.Tuples), ContinuationToken: page.ContinuationToken}, gen)
+	return page, nil
+}
+
+// readTuples sends one read_tuples request to fga-sync.
+func (c *AccessCheckClient) readTuples(ctx context.Context, query ReadTuplesQuery) (TuplesPage, error) {
+	req := readTuplesRequest{
+		User:              query.User,
+		Relation:          query.Relation,
+		PageSize:          query.PageSize,
+		ContinuationToken: query.ContinuationToken,
+	}
+	if len(query.ObjectTypes) == 1 {
+		req.ObjectType = query.ObjectTypes[0]
+	} else {
+		req.ObjectTypes = query.ObjectTypes
+	}
+
+	reqPayload, err := json.Marshal(req)
+	if err != nil {
+		return TuplesPage{}, fmt.Errorf("%w: failed to build read tuples request: %v", constants.ErrUnexpectedResponse, err)
+	}
+
+	responseData, err := c.messagingRepo.Request(ctx, constants.ReadTuplesSubject, reqPayload, requestTimeout(ctx))
+	if err != nil {
+		return TuplesPage{}, fmt.Errorf("NATS request to subject %s failed: %w", constants.ReadTuplesSubject, err)
+	}
+
+	var resp readTuplesResponse
+	if err := json.Unmarshal(responseData, &resp); err != nil {
+		return TuplesPage{}, fmt.Errorf("%w: failed to parse read tuples response: %v", constants.ErrUnexpectedResponse, err)
+	}
+
+	if resp.Error != "" {
+		return TuplesPage{}, fmt.Errorf("message to subject %s failed with FGA error: %s", constants.ReadTuplesSubject, resp.Error)
+	}
+
+	if query.PageSize > 0 && len(resp.Results) > query.PageSize {
+		// Responders that predate paging ignore page_size and reply with
+		// every tuple; pass those through as a single, final page.
+		slog.DebugContext(ctx, "read tuples reply exceeds the page size", "page_size", query.PageSize, "results", len(resp.Results))
+	}
+
+	tuples := make([]domain.Tuple, 0, len(resp.Results))
+	for _, result := range resp.Results {
+		t, err := domain.ParseTuple(result)
+		if err != nil {
+			return TuplesPage{}, fmt.Errorf("%w: %v", constants.ErrUnexpectedResponse, err)
+		}
+		if !slices.Contains(query.ObjectTypes, t.ObjectType()) {
+			continue
+		}
+		if query.Relation != "" && t.Relation != query.Relation {
+			continue
+		}
+		tuples = append(tuples, t)
+	}
+	return TuplesPage{Tuples: tuples, ContinuationToken: resp.ContinuationToken}, nil
 }
 
-// ReadTuples fetches the direct OpenFGA tuples for a principal via NATS.
-func (c *AccessCheckClient) ReadTuples(ctx context.Context, principal string, objectType string) ([]string, error) {
-	reqPayload, err := json.Marshal(readTuplesRequest{
-		User:       constants.UserTypePrefix + principal,
+// ListObjects fetches every object of objectType on which user holds relation
+// via NATS. Unlike ReadTuples, fga-sync resolves the relation through OpenFGA's
+// ListObjects, so access inherited through parent objects is included. Every
+// returned object is checked with domain.ValidateObject and must be of
+// objectType; a malformed entry fails the whole call with ErrUnexpectedResponse.
+func (c *AccessCheckClient) ListObjects(ctx context.Context, user string, objectType string, relation string) ([]string, error) {
+	reqPayload, err := json.Marshal(listObjectsRequest{
+		User:       user,
 		ObjectType: objectType,
+		Relation:   relation,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("%w: failed to build read tuples request: %v", constants.ErrUnexpectedResponse, err)
+		return nil, fmt.Errorf("%w: failed to build list objects request: %v", constants.ErrUnexpectedResponse, err)
 	}
 
-	responseData, err := c.messagingRepo.Request(ctx, constants.ReadTuplesSubject, reqPayload, constants.DefaultNATSTimeout)
+	responseData, err := c.messagingRepo.Request(ctx, constants.ListObjectsSubject, reqPayload, requestTimeout(ctx))
 	if err != nil {
-		return nil, fmt.Errorf("NATS request to subject %s failed: %w", constants.ReadTuplesSubject, err)
+		return nil, fmt.Errorf("NATS request to subject %s failed: %w", constants.ListObjectsSubject, err)
 	}
 
-	var resp readTuplesResponse
+	var resp listObjectsResponse
 	if err := json.Unmarshal(responseData, &resp); err != nil {
-		return nil, fmt.Errorf("%w: failed to parse read tuples response: %v", constants.ErrUnexpectedResponse, err)
+		return nil, fmt.Errorf("%w: failed to parse list objects response: %v", constants.ErrUnexpectedResponse, err)
 	}
 
 	if resp.Error != "" {
-		return nil, fmt.Errorf("message to subject %s failed with FGA error: %s", constants.ReadTuplesSubject, resp.Error)
+		return nil, fmt.Errorf("message to subject %s failed with FGA error: %s", constants.ListObjectsSubject, resp.Error)
 	}
 
-	if resp.Results == nil {
-		return []string{}, nil
+	objects := make([]string, 0, len(resp.Results))
+	for _, object := range resp.Results {
+		if err := domain.ValidateObject(object); err != nil {
+			return nil, fmt.Errorf("%w: list objects returned %q: %v", constants.ErrUnexpectedResponse, object, err)
+		}
+		if gotType := (domain.Tuple{Object: object}).ObjectType(); gotType != objectType {
+			return nil, fmt.Errorf("%w: list objects returned %q for object type %q", constants.ErrUnexpectedResponse, object, objectType)
+		}
+		objects = append(objects, object)
 	}
-	return resp.Results, nil
+	return objects, nil
 }
 
-// buildMessage constructs the newline-separated plaintext NATS payload for
-// access-check requests in the form "object#relation@user:principal".
-// Empty resource strings are skipped.
-func (c *AccessCheckClient) buildMessage(principal string, resources []string) string {
-	var builder strings.Builder
+// ListUsers fetches the users and usersets holding relation on object via
+// NATS. Every returned entry is checked with domain.ValidateUser; a malformed
+// entry fails the whole call with ErrUnexpectedResponse.
+func (c *AccessCheckClient) ListUsers(ctx context.Context, object string, relation string) ([]string, error) {
+	reqPayload, err := json.Marshal(listUsersRequest{
+		Object:   object,
+		Relation: relation,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to build list users request: %v", constants.ErrUnexpectedResponse, err)
+	}
+
+	responseData, err := c.messagingRepo.Request(ctx, constants.ListUsersSubject, reqPayload, requestTimeout(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("NATS request to subject %s failed: %w", constants.ListUsersSubject, err)
+	}
 
-	totalCapacity := 0
-	for _, resource := range resources {
-		if resource != "" {
-			// resource + "@user:" + principal + newline
-			totalCapacity += len(resource) + len(constants.UserRelationPrefix) + len(principal) + 1
+	var resp listUsersResponse
+	if err := json.Unmarshal(responseData, &resp); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse list users response: %v", constants.ErrUnexpectedResponse, err)
+	}
+
+	if resp.Error != "" {
+		return nil, fmt.Errorf("message to subject %s failed with FGA error: %s", constants.ListUsersSubject, resp.Error)
+	}
+
+	users := make([]string, 0, len(resp.Results))
+	for _, user := range resp.Results {
+		if err := domain.ValidateUser(user); err != nil {
+			return nil, fmt.Errorf("%w: %v", constants.ErrUnexpectedResponse, err)
 		}
+		users = append(users, user)
 	}
+	return users, nil
+}
 
-	if totalCapacity > 0 {
-		builder.Grow(totalCapacity)
+// validateChecks checks every tuple and returns a single ErrInvalidAccessRequest
+// listing the index and reason of each invalid one.
+func validateChecks(checks []domain.Tuple) error {
+	var problems []string
+	for i, check := range checks {
+		if err := check.Validate(); err != nil {
+			problems = append(problems, fmt.Sprintf("requests[%d]: %v", i, err))
+		}
 	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", constants.ErrInvalidAccessRequest, strings.Join(problems, "; "))
+	}
+	return nil
+}
 
-	for _, resource := range resources {
-		if resource == "" {
-			continue
+// buildMessage constructs the newline-separated plaintext NATS payload for
+// access-check requests, one "object#relation@user" tuple per line.
+// Duplicate tuples are sent once; orderResults fans the single answer back
+// out to every duplicate.
+func (c *AccessCheckClient) buildMessage(checks []domain.Tuple) string {
+	var builder strings.Builder
+
+	for _, check := range uniqueTuples(checks) {
+		if builder.Len() > 0 {
+			builder.WriteByte('\n')
 		}
-		builder.WriteString(resource)
-		builder.WriteString(constants.UserRelationPrefix)
-		builder.WriteString(principal)
-		builder.WriteByte('\n')
+		builder.WriteString(check.String())
 	}
 
-	message := builder.String()
-	if len(message) > 0 && message[len(message)-1] == '\n' {
-		message = message[:len(message)-1]
+	return builder.String()
+}
+
+// buildContextualMessage constructs the versioned JSON NATS payload used when
+// checks carry contextual tuples or condition context. Like buildMessage it
+// sends duplicate checks once. The leading '{' can never start a plaintext
+// tuple line, so fga-sync can tell the two encodings apart.
+func (c *AccessCheckClient) buildContextualMessage(checks []domain.Tuple, checkCtx domain.CheckContext) ([]byte, error) {
+	req := contextualCheckRequest{
+		Version:          constants.ContextualCheckPayloadVersion,
+		Checks:           make([]string, 0, len(checks)),
+		ContextualTuples: make([]string, 0, len(checkCtx.ContextualTuples)),
+		Context:          checkCtx.Context,
+	}
+	for _, check := range uniqueTuples(checks) {
+		req.Checks = append(req.Checks, check.String())
+	}
+	for _, t := range checkCtx.ContextualTuples {
+		req.ContextualTuples = append(req.ContextualTuples, t.String())
 	}
 
-	return message
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to encode check context: %v", constants.ErrInvalidAccessRequest, err)
+	}
+	return payload, nil
 }
 
-// parseResponse validates and splits the NATS access-check reply into result lines.
+// uniqueTuples returns tuples without duplicates, keeping first-seen order.
+func uniqueTuples(tuples []domain.Tuple) []domain.Tuple {
+	seen := make(map[domain.Tuple]struct{}, len(tuples))
+	unique := make([]domain.Tuple, 0, len(tuples))
+	for _, t := range tuples {
+		if _, dup := seen[t]; dup {
+			continue
+		}
+		seen[t] = struct{}{}
+		unique = append(unique, t)
+	}
+	return unique
+}
+
+// parseResponse validates and parses the NATS access-check reply into results.
 // A space appearing in the first DefaultResponseSanityCheckBytes bytes indicates an
 // error message from fga-sync rather than a valid result payload.
-func (c *AccessCheckClient) parseResponse(responseData []byte) ([]string, error) {
+func (c *AccessCheckClient) parseResponse(responseData []byte) ([]domain.CheckResult, error) {
 	topRange := constants.DefaultResponseSanityCheckBytes
 	if len(responseData) < topRange {
 		topRange = len(responseData)
@@ -145,25 +813,112 @@ func (c *AccessCheckClient) parseResponse(responseData []byte) ([]string, error)
 	}
 
 	lines := bytes.Split(responseData, []byte("\n"))
-	results := make([]string, 0, len(lines))
+	results := make([]domain.CheckResult, 0, len(lines))
 	for _, line := range lines {
 		line = bytes.TrimSpace(line)
 		if len(line) == 0 {
 			continue
 		}
-		results = append(results, string(line))
+		result, err := domain.ParseCheckResult(string(line))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", constants.ErrUnexpectedResponse, err)
+		}
+		results = append(results, result)
 	}
 	return results, nil
 }
 
+// orderResults rebuilds the fga-sync reply so that the i-th result answers the
+// i-th check. Every requested tuple must be answered exactly once (repeated
+// identical answers are tolerated), and no unrequested tuple may appear.
+func (c *AccessCheckClient) orderResults(checks []domain.Tuple, results []domain.CheckResult) ([]domain.CheckResult, error) {
+	byTuple := make(map[domain.Tuple]bool, len(results))
+	for _, result := range results {
+		if prev, dup := byTuple[result.Tuple]; dup && prev != result.Allowed {
+			return nil, fmt.Errorf("%w: %s: conflicting results for %q", constants.ErrUnexpectedResponse, constants.ErrMsgResultMismatch, result.Tuple)
+		}
+		byTuple[result.Tuple] = result.Allowed
+	}
+
+	ordered := make([]domain.CheckResult, 0, len(checks))
+	requested := make(map[domain.Tuple]struct{}, len(checks))
+	for _, check := range checks {
+		allowed, ok := byTuple[check]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s: missing result for %q", constants.ErrUnexpectedResponse, constants.ErrMsgResultMismatch, check)
+		}
+		requested[check] = struct{}{}
+		ordered = append(ordered, domain.CheckResult{Tuple: check, Allowed: allowed})
+	}
+
+	if len(requested) != len(byTuple) {
+		for tuple := range byTuple {
+			if _, ok := requested[tuple]; !ok {
+				return nil, fmt.Errorf("%w: %s: unrequested result for %q", constants.ErrUnexpectedResponse, constants.ErrMsgResultMismatch, tuple)
+			}
+		}
+	}
+
+	return ordered, nil
+}
+
+// contextualCheckRequest is the versioned JSON access-check payload sent to
+// fga-sync when checks carry contextual tuples or condition context. Checks and
+// ContextualTuples hold "object#relation@user" tuple-strings.
+type contextualCheckRequest struct {
+	Version          string         `json:"version"`
+	Checks           []string       `json:"checks"`
+	ContextualTuples []string       `json:"contextual_tuples,omitempty"`
+	Context          map[string]any `json:"context,omitempty"`
+}
+
+// tupleChangeEvent is the JSON event fga-sync publishes when tuples are
+// written or deleted. Tuples holds "object#relation@user" tuple-strings.
+type tupleChangeEvent struct {
+	Operation string   `json:"operation"`
+	Tuples    []string `json:"tuples"`
+}
+
 // readTuplesRequest is the JSON payload sent to fga-sync over NATS.
 type readTuplesRequest struct {
-	User       string `json:"user"`
-	ObjectType string `json:"object_type"`
+	User              string   `json:"user"`
+	ObjectType        string   `json:"object_type,omitempty"`
+	ObjectTypes       []string `json:"object_types,omitempty"`
+	Relation          string   `json:"relation,omitempty"`
+	PageSize          int      `json:"page_size,omitempty"`
+	ContinuationToken string   `json:"continuation_token,omitempty"`
 }
 
 // readTuplesResponse is the JSON response received from fga-sync over NATS.
 type readTuplesResponse struct {
+	Results           []string `json:"results,omitempty"`
+	ContinuationToken string   `json:"continuation_token,omitempty"`
+	Error             string   `json:"error,omitempty"`
+}
+
+// listObjectsRequest is the JSON payload sent to fga-sync over NATS.
+type listObjectsRequest struct {
+	User       string `json:"user"`
+	ObjectType string `json:"object_type"`
+	Relation   string `json:"relation"`
+}
+
+// listObjectsResponse is the JSON response received from fga-sync over NATS.
+// Results holds objects in "type:id" form.
+type listObjectsResponse struct {
+	Results []string `json:"results,omitempty"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// listUsersRequest is the JSON payload sent to fga-sync over NATS.
+type listUsersRequest struct {
+	Object   string `json:"object"`
+	Relation string `json:"relation"`
+}
+
+// listUsersResponse is the JSON response received from fga-sync over NATS.
+// Results holds users ("user:auth0|alice") and usersets ("team:xyz#member").
+type listUsersResponse struct {
 	Results []string `json:"results,omitempty"`
 	Error   string   `json:"error,omitempty"`
 }
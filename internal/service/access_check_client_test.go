@@ -5,11 +5,20 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/cache"
 	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
 )
 
@@ -21,24 +30,59 @@ func newTestClient(requestFunc func(ctx context.Context, subject string, data []
 	return NewAccessCheckClient(&mockMessagingRepository{requestFunc: requestFunc})
 }
 
+// decisionCacheStats reports decision cache hits, misses and size, or zero
+// Stats when the cache is disabled.
+func decisionCacheStats(c *AccessCheckClient) cache.Stats {
+	if c.decisions == nil {
+		return cache.Stats{}
+	}
+	return c.decisions.Stats()
+}
+
+// grantsCacheStats reports grants cache hits, misses and size, or zero Stats
+// when the cache is disabled.
+func grantsCacheStats(c *AccessCheckClient) cache.Stats {
+	if c.grants == nil {
+		return cache.Stats{}
+	}
+	return c.grants.Stats()
+}
+
 // ----- CheckAccess -----
 
-func TestAccessCheckClient_CheckAccess_EmptyPrincipal(t *testing.T) {
-	client := NewAccessCheckClient(&mockMessagingRepository{})
+// aliceChecks returns check tuples for user:alice from "type:id#relation" strings.
+func aliceChecks(t *testing.T, requests ...string) []domain.Tuple {
+	t.Helper()
+	checks := make([]domain.Tuple, 0, len(requests))
+	for _, r := range requests {
+		check, err := domain.ParseObjectRelation(r)
+		if err != nil {
+			t.Fatalf("invalid test request %q: %v", r, err)
+		}
+		checks = append(checks, check.WithUser("user:alice"))
+	}
+	return checks
+}
 
-	_, err := client.CheckAccess(context.Background(), "", []string{"resource1"})
+func TestAccessCheckClient_CheckAccess_EmptyUser(t *testing.T) {
+	client := newTestClient(func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+		t.Fatal("no NATS request should be sent without a user")
+		return nil, nil
+	})
+
+	_, err := client.CheckAccess(context.Background(), []domain.Tuple{{Object: "project:abc", Relation: "viewer"}})
 	if err == nil {
-		t.Fatal("CheckAccess should fail with empty principal")
+		t.Fatal("CheckAccess should fail with empty user")
 	}
-	if !errors.Is(err, constants.ErrPrincipalRequired) {
-		t.Errorf("expected ErrPrincipalRequired, got %v", err)
+	if !errors.Is(err, constants.ErrInvalidAccessRequest) {
+		t.Errorf("expected ErrInvalidAccessRequest, got %v", err)
 	}
 }
 
 func TestAccessCheckClient_CheckAccess_EmptyResources(t *testing.T) {
 	client := NewAccessCheckClient(&mockMessagingRepository{})
 
-	result, err := client.CheckAccess(context.Background(), "test-user", []string{})
+	result, err := client.CheckAccess(context.Background(), []domain.Tuple{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -52,7 +96,7 @@ func TestAccessCheckClient_CheckAccess_UnexpectedResponse(t *testing.T) {
 		return []byte("error message here"), nil
 	})
 
-	_, err := client.CheckAccess(context.Background(), "test-user", []string{"resource1"})
+	_, err := client.CheckAccess(context.Background(), aliceChecks(t, "project:abc#viewer"))
 	if err == nil {
 		t.Fatal("CheckAccess should fail on space-containing response")
 	}
@@ -66,7 +110,7 @@ func TestAccessCheckClient_CheckAccess_NATSFailure(t *testing.T) {
 		return nil, errors.New("NATS connection failed")
 	})
 
-	_, err := client.CheckAccess(context.Background(), "test-user", []string{"resource1"})
+	_, err := client.CheckAccess(context.Background(), aliceChecks(t, "project:abc#viewer"))
 	if err == nil {
 		t.Fatal("expected error on NATS failure")
 	}
@@ -75,14 +119,67 @@ func TestAccessCheckClient_CheckAccess_NATSFailure(t *testing.T) {
 	}
 }
 
+func TestAccessCheckClient_CheckAccess_InvalidTuples(t *testing.T) {
+	valid := domain.Tuple{Object: "project:abc", Relation: "viewer", User: "user:alice"}
+
+	tests := []struct {
+		name      string
+		checks    []domain.Tuple
+		wantIndex []string
+	}{
+		{
+			name:      "newline in object",
+			checks:    []domain.Tuple{valid, {Object: "project:abc\nproject:xyz", Relation: "owner", User: "user:alice"}},
+			wantIndex: []string{"requests[1]"},
+		},
+		{
+			name:      "user smuggled into relation",
+			checks:    []domain.Tuple{{Object: "project:abc", Relation: "viewer@user:bob", User: "user:alice"}},
+			wantIndex: []string{"requests[0]"},
+		},
+		{
+			name:      "newline in user",
+			checks:    []domain.Tuple{{Object: "project:abc", Relation: "viewer", User: "user:alice\nproject:xyz#owner@user:bob"}},
+			wantIndex: []string{"requests[0]"},
+		},
+		{
+			name:      "several invalid tuples",
+			checks:    []domain.Tuple{{}, valid, {Object: "project:abc", Relation: "viewer"}},
+			wantIndex: []string{"requests[0]", "requests[2]"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+				t.Fatal("no NATS request should be sent for invalid tuples")
+				return nil, nil
+			})
+
+			_, err := client.CheckAccess(context.Background(), tc.checks)
+			if !errors.Is(err, constants.ErrInvalidAccessRequest) {
+				t.Fatalf("expected ErrInvalidAccessRequest, got %v", err)
+			}
+			for _, idx := range tc.wantIndex {
+				if !strings.Contains(err.Error(), idx) {
+					t.Errorf("expected error to name %s, got %v", idx, err)
+				}
+			}
+			if got := strings.Count(err.Error(), "requests["); got != len(tc.wantIndex) {
+				t.Errorf("expected %d invalid entries reported, got %d: %v", len(tc.wantIndex), got, err)
+			}
+		})
+	}
+}
+
 func TestAccessCheckClient_CheckAccess_CorrectSubject(t *testing.T) {
 	var gotSubject string
 	client := newTestClient(func(_ context.Context, subject string, _ []byte, _ time.Duration) ([]byte, error) {
 		gotSubject = subject
-		return []byte("true\nfalse"), nil
+		return []byte("project:abc#viewer@user:alice\ttrue"), nil
 	})
 
-	_, err := client.CheckAccess(context.Background(), "alice", []string{"project:abc#viewer"})
+	_, err := client.CheckAccess(context.Background(), aliceChecks(t, "project:abc#viewer"))
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -91,6 +188,743 @@ func TestAccessCheckClient_CheckAccess_CorrectSubject(t *testing.T) {
 	}
 }
 
+func TestAccessCheckClient_CheckAccess_TimeoutFollowsDeadline(t *testing.T) {
+	var gotTimeout time.Duration
+	client := newTestClient(func(_ context.Context, _ string, _ []byte, timeout time.Duration) ([]byte, error) {
+		gotTimeout = timeout
+		return []byte("project:abc#viewer@user:alice\ttrue"), nil
+	})
+
+	if _, err := client.CheckAccess(context.Background(), aliceChecks(t, "project:abc#viewer")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotTimeout != constants.DefaultNATSTimeout {
+		t.Errorf("expected default timeout %v without a deadline, got %v", constants.DefaultNATSTimeout, gotTimeout)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	if _, err := client.CheckAccess(ctx, aliceChecks(t, "project:abc#viewer")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotTimeout <= 0 || gotTimeout > 500*time.Millisecond {
+		t.Errorf("expected timeout bounded by the 500ms deadline, got %v", gotTimeout)
+	}
+}
+
+func TestAccessCheckClient_CheckAccessWithContext_VersionedPayload(t *testing.T) {
+	client := newTestClient(func(_ context.Context, subject string, data []byte, _ time.Duration) ([]byte, error) {
+		if subject != constants.AccessCheckSubject {
+			t.Errorf("unexpected NATS subject: %s", subject)
+		}
+		var req contextualCheckRequest
+		if err := json.Unmarshal(data, &req); err != nil {
+			t.Fatalf("expected JSON payload, got %q: %v", data, err)
+		}
+		expected := contextualCheckRequest{
+			Version:          constants.ContextualCheckPayloadVersion,
+			Checks:           []string{"project:abc#viewer@user:alice"},
+			ContextualTuples: []string{"project:abc#viewer@user:auth0|bob"},
+			Context:          map[string]any{"current_time": "2026-01-01T00:00:00Z"},
+		}
+		if !reflect.DeepEqual(req, expected) {
+			t.Errorf("expected payload %+v, got %+v", expected, req)
+		}
+		return []byte("project:abc#viewer@user:alice\ttrue"), nil
+	})
+
+	results, err := client.CheckAccessWithContext(context.Background(),
+		aliceChecks(t, "project:abc#viewer", "project:abc#viewer"),
+		domain.CheckContext{
+			ContextualTuples: []domain.Tuple{{Object: "project:abc", Relation: "viewer", User: "user:auth0|bob"}},
+			Context:          map[string]any{"current_time": "2026-01-01T00:00:00Z"},
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 2 || !results[0].Allowed || !results[1].Allowed {
+		t.Errorf("expected two allowed results, got %+v", results)
+	}
+}
+
+func TestAccessCheckClient_CheckAccessWithContext_ZeroContextIsPlaintext(t *testing.T) {
+	client := newTestClient(func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+		if string(data) != "project:abc#viewer@user:alice" {
+			t.Errorf("expected plaintext payload, got %q", data)
+		}
+		return []byte("project:abc#viewer@user:alice\tfalse"), nil
+	})
+
+	if _, err := client.CheckAccessWithContext(context.Background(), aliceChecks(t, "project:abc#viewer"), domain.CheckContext{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestAccessCheckClient_CheckAccessWithContext_InvalidContextualTuple(t *testing.T) {
+	client := newTestClient(func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+		t.Error("nothing should be sent when a contextual tuple is invalid")
+		return nil, nil
+	})
+
+	_, err := client.CheckAccessWithContext(context.Background(), aliceChecks(t, "project:abc#viewer"), domain.CheckContext{
+		ContextualTuples: []domain.Tuple{{Object: "project:abc", Relation: "viewer", User: "user:alice\nproject:xyz#owner@user:bob"}},
+	})
+	if !errors.Is(err, constants.ErrInvalidAccessRequest) {
+		t.Fatalf("expected ErrInvalidAccessRequest, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "contextual_tuples[0]") {
+		t.Errorf("expected error to name contextual_tuples[0], got %v", err)
+	}
+}
+
+func TestAccessCheckClient_CheckAccess_RequestOrder(t *testing.T) {
+	client := newTestClient(func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+		if got := strings.Count(string(data), "\n") + 1; got != 2 {
+			t.Errorf("expected duplicate tuples to be sent once (2 lines), got %d lines", got)
+		}
+		// Cached hit first, fresh check second, as fga-sync does.
+		return []byte("committee:xyz#writer@user:alice\tfalse\nproject:abc#viewer@user:alice\ttrue"), nil
+	})
+
+	results, err := client.CheckAccess(context.Background(), aliceChecks(t,
+		"project:abc#viewer",
+		"committee:xyz#writer",
+		"project:abc#viewer",
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"project:abc#viewer@user:alice\ttrue",
+		"committee:xyz#writer@user:alice\tfalse",
+		"project:abc#viewer@user:alice\ttrue",
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("expected %d results, got %d: %v", len(expected), len(results), results)
+	}
+	for i, want := range expected {
+		if results[i].String() != want {
+			t.Errorf("result[%d]: expected %q, got %q", i, want, results[i].String())
+		}
+	}
+}
+
+func TestAccessCheckClient_CheckAccess_ReplyMismatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		reply string
+	}{
+		{
+			name:  "missing tuple",
+			reply: "project:abc#viewer@user:alice\ttrue",
+		},
+		{
+			name:  "unrequested tuple",
+			reply: "project:abc#viewer@user:alice\ttrue\ncommittee:xyz#writer@user:alice\tfalse\nproject:other#viewer@user:alice\ttrue",
+		},
+		{
+			name:  "tuple for another principal",
+			reply: "project:abc#viewer@user:bob\ttrue\ncommittee:xyz#writer@user:alice\tfalse",
+		},
+		{
+			name:  "conflicting answers",
+			reply: "project:abc#viewer@user:alice\ttrue\ncommittee:xyz#writer@user:alice\tfalse\nproject:abc#viewer@user:alice\tfalse",
+		},
+		{
+			name:  "line without decision",
+			reply: "project:abc#viewer@user:alice\ncommittee:xyz#writer@user:alice\tfalse",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+				return []byte(tc.reply), nil
+			})
+
+			_, err := client.CheckAccess(context.Background(), aliceChecks(t, "project:abc#viewer", "committee:xyz#writer"))
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !errors.Is(err, constants.ErrUnexpectedResponse) {
+				t.Errorf("expected ErrUnexpectedResponse, got %v", err)
+			}
+		})
+	}
+}
+
+// ----- Decision cache -----
+
+// projectGrantReply answers every plaintext access-check line, allowing
+// project tuples and denying everything else.
+func projectGrantReply(data []byte) []byte {
+	lines := strings.Split(string(data), "\n")
+	reply := make([]string, 0, len(lines))
+	for _, line := range lines {
+		reply = append(reply, line+"\t"+strconv.FormatBool(strings.HasPrefix(line, "project:")))
+	}
+	return []byte(strings.Join(reply, "\n"))
+}
+
+func TestAccessCheckClient_DecisionCache_OnlyMissesSent(t *testing.T) {
+	var sent []string
+	client := newTestClient(func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+		sent = append(sent, string(data))
+		return projectGrantReply(data), nil
+	})
+	client.EnableDecisionCache(100, time.Minute)
+
+	if _, err := client.CheckAccess(context.Background(), aliceChecks(t, "project:abc#viewer", "committee:xyz#writer")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	results, err := client.CheckAccess(context.Background(), aliceChecks(t,
+		"committee:xyz#writer",
+		"project:def#viewer",
+		"project:abc#viewer",
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantSent := []string{
+		"project:abc#viewer@user:alice\ncommittee:xyz#writer@user:alice",
+		"project:def#viewer@user:alice",
+	}
+	if !slices.Equal(sent, wantSent) {
+		t.Errorf("expected payloads %q, got %q", wantSent, sent)
+	}
+
+	expected := []string{
+		"committee:xyz#writer@user:alice\tfalse",
+		"project:def#viewer@user:alice\ttrue",
+		"project:abc#viewer@user:alice\ttrue",
+	}
+	for i, want := range expected {
+		if results[i].String() != want {
+			t.Errorf("result[%d]: expected %q, got %q", i, want, results[i].String())
+		}
+	}
+
+	stats := decisionCacheStats(client)
+	if stats.Hits != 2 || stats.Misses != 3 || stats.Len != 3 {
+		t.Errorf("expected 2 hits, 3 misses, 3 entries, got %+v", stats)
+	}
+}
+
+func TestAccessCheckClient_DecisionCache_KeyedByPrincipal(t *testing.T) {
+	requests := 0
+	client := newTestClient(func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+		requests++
+		return projectGrantReply(data), nil
+	})
+	client.EnableDecisionCache(100, time.Minute)
+
+	check := aliceChecks(t, "project:abc#viewer")[0]
+	for _, user := range []string{"user:alice", "user:bob", "user:alice"} {
+		if _, err := client.CheckAccess(context.Background(), []domain.Tuple{check.WithUser(user)}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("expected one request per principal (2), got %d", requests)
+	}
+}
+
+func TestAccessCheckClient_DecisionCache_Bypass(t *testing.T) {
+	tests := []struct {
+		name      string
+		size      int
+		checkCtx  domain.CheckContext
+		replyErr  error
+		wantCalls int
+	}{
+		{
+			name:      "disabled",
+			size:      0,
+			wantCalls: 2,
+		},
+		{
+			name:      "check context",
+			size:      100,
+			checkCtx:  domain.CheckContext{Context: map[string]any{"ip": "10.0.0.1"}},
+			wantCalls: 2,
+		},
+		{
+			name:      "errors are not cached",
+			size:      100,
+			replyErr:  errors.New("nats: timeout"),
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			client := newTestClient(func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+				calls++
+				if tt.replyErr != nil {
+					return nil, tt.replyErr
+				}
+				if !tt.checkCtx.IsZero() {
+					return []byte("project:abc#viewer@user:alice\ttrue"), nil
+				}
+				return projectGrantReply(data), nil
+			})
+			client.EnableDecisionCache(tt.size, time.Minute)
+
+			for i := 0; i < 2; i++ {
+				_, err := client.CheckAccessWithContext(context.Background(), aliceChecks(t, "project:abc#viewer"), tt.checkCtx)
+				if (err != nil) != (tt.replyErr != nil) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("expected %d NATS requests, got %d", tt.wantCalls, calls)
+			}
+			if stats := decisionCacheStats(client); stats.Hits != 0 {
+				t.Errorf("expected no cache hits, got %+v", stats)
+			}
+		})
+	}
+}
+
+// ----- Cache invalidation -----
+
+// newCachingTestClient returns a client with both caches enabled whose
+// responder grants project relations and lists one writer grant per read.
+func newCachingTestClient(requests *int) *AccessCheckClient {
+	client := NewAccessCheckClient(&mockMessagingRepository{
+		requestFunc: func(_ context.Context, subject string, data []byte, _ time.Duration) ([]byte, error) {
+			*requests++
+			if subject == constants.ReadTuplesSubject {
+				var req readTuplesRequest
+				if err := json.Unmarshal(data, &req); err != nil {
+					return nil, err
+				}
+				return json.Marshal(readTuplesResponse{Results: []string{"project:abc#writer@" + req.User}})
+			}
+			return projectGrantReply(data), nil
+		},
+	})
+	client.EnableDecisionCache(100, time.Minute)
+	client.EnableGrantsCache(100, time.Minute)
+	return client
+}
+
+func TestAccessCheckClient_DegradedMode(t *testing.T) {
+	errUnavailable := errors.New("nats: no responders available for request")
+
+	tests := []struct {
+		name        string
+		failClosed  bool
+		failure     error
+		checks      []string
+		wantErr     error
+		wantAllowed []bool
+	}{
+		{
+			name:        "expired decisions served stale",
+			failure:     errUnavailable,
+			checks:      []string{"project:abc#viewer", "committee:xyz#writer"},
+			wantAllowed: []bool{true, false},
+		},
+		{
+			name:    "uncached check fails the call",
+			failure: errUnavailable,
+			checks:  []string{"project:abc#viewer", "project:new#viewer"},
+			wantErr: errUnavailable,
+		},
+		{
+			name:        "uncached check fails closed",
+			failClosed:  true,
+			failure:     errUnavailable,
+			checks:      []string{"project:abc#viewer", "project:new#viewer"},
+			wantAllowed: []bool{true, false},
+		},
+		{
+			name:    "malformed reply is not masked",
+			failure: constants.ErrUnexpectedResponse,
+			checks:  []string{"project:abc#viewer"},
+			wantErr: constants.ErrUnexpectedResponse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var failing bool
+			client := newTestClient(func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+				if failing {
+					return nil, tt.failure
+				}
+				return projectGrantReply(data), nil
+			})
+			// Every entry expires at once, so later calls must go to fga-sync.
+			client.EnableDecisionCache(100, time.Nanosecond)
+			client.EnableDegradedMode(time.Minute, tt.failClosed)
+
+			if _, err := client.CheckAccess(context.Background(), aliceChecks(t, "project:abc#viewer", "committee:xyz#writer")); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			failing = true
+			results, err := client.CheckAccess(context.Background(), aliceChecks(t, tt.checks...))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for i, result := range results {
+				if result.Allowed != tt.wantAllowed[i] || !result.Stale {
+					t.Errorf("result %d = %+v; want allowed=%v and stale", i, result, tt.wantAllowed[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAccessCheckClient_DegradedMode_CallerContextIsNotAnOutage(t *testing.T) {
+	var failing bool
+	client := newTestClient(func(ctx context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+		if failing {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return projectGrantReply(data), nil
+	})
+	client.EnableDecisionCache(100, time.Nanosecond)
+	client.EnableDegradedMode(time.Minute, true)
+
+	if _, err := client.CheckAccess(context.Background(), aliceChecks(t, "project:abc#viewer")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	failing = true
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	results, err := client.CheckAccess(ctx, aliceChecks(t, "project:abc#viewer"))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the caller's deadline error, got %v (results %v)", err, results)
+	}
+}
+
+func TestAccessCheckClient_DegradedMode_DisabledByDefault(t *testing.T) {
+	var failing bool
+	client := newTestClient(func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+		if failing {
+			return nil, errors.New("nats: no responders available for request")
+		}
+		return projectGrantReply(data), nil
+	})
+	client.EnableDecisionCache(100, time.Nanosecond)
+
+	if _, err := client.CheckAccess(context.Background(), aliceChecks(t, "project:abc#viewer")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	failing = true
+	if _, err := client.CheckAccess(context.Background(), aliceChecks(t, "project:abc#viewer")); err == nil {
+		t.Fatal("expected the failure to surface without degraded mode")
+	}
+}
+
+func TestAccessCheckClient_GrantsCache(t *testing.T) {
+	requests := 0
+	client := newCachingTestClient(&requests)
+	query := ReadTuplesQuery{User: "user:alice", ObjectTypes: []string{"project"}, PageSize: 10}
+
+	for i := 0; i < 2; i++ {
+		page, err := client.ReadTuples(context.Background(), query)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(page.Tuples) != 1 {
+			t.Fatalf("expected one tuple, got %v", page.Tuples)
+		}
+		page.Tuples[0].Relation = "mutated"
+	}
+	if requests != 1 {
+		t.Errorf("expected one read_tuples request, got %d", requests)
+	}
+
+	query.ContinuationToken = "next"
+	if _, err := client.ReadTuples(context.Background(), query); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected another page to be requested, got %d requests", requests)
+	}
+
+	stats := grantsCacheStats(client)
+	if stats.Hits != 1 || stats.Misses != 2 {
+		t.Errorf("expected 1 hit and 2 misses, got %+v", stats)
+	}
+}
+
+func TestAccessCheckClient_InvalidateTuples(t *testing.T) {
+	tests := []struct {
+		name          string
+		changed       string
+		wantDecisions []string
+		wantGrants    []string
+	}{
+		{
+			name:          "direct grant evicts only that principal",
+			changed:       "committee:xyz#writer@user:alice",
+			wantDecisions: []string{"user:bob"},
+			wantGrants:    []string{"user:bob"},
+		},
+		{
+			name:          "userset grant purges every decision",
+			changed:       "project:abc#viewer@team:core#member",
+			wantDecisions: nil,
+			wantGrants:    []string{"user:alice", "user:bob"},
+		},
+		{
+			name:          "wildcard grant purges every decision",
+			changed:       "project:abc#viewer@user:*",
+			wantDecisions: nil,
+			wantGrants:    []string{"user:alice", "user:bob"},
+		},
+		{
+			name:          "parent change purges every decision",
+			changed:       "committee:xyz#project@project:abc",
+			wantDecisions: nil,
+			wantGrants:    []string{"user:alice", "user:bob"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			client := newCachingTestClient(&requests)
+			users := []string{"user:alice", "user:bob"}
+			check := aliceChecks(t, "project:abc#viewer")[0]
+			for _, user := range users {
+				if _, err := client.CheckAccess(context.Background(), []domain.Tuple{check.WithUser(user)}); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if _, err := client.ReadTuples(context.Background(), ReadTuplesQuery{User: user, ObjectTypes: []string{"project"}}); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			changed, err := domain.ParseTuple(tt.changed)
+			if err != nil {
+				t.Fatalf("invalid test tuple %q: %v", tt.changed, err)
+			}
+			client.InvalidateTuples(context.Background(), []domain.Tuple{changed})
+
+			var gotDecisions, gotGrants []string
+			for _, user := range users {
+				if _, ok := client.decisions.Get(check.WithUser(user)); ok {
+					gotDecisions = append(gotDecisions, user)
+				}
+				if _, ok := client.grants.Get(grantsCacheKey{user: user, objectTypes: "project"}); ok {
+					gotGrants = append(gotGrants, user)
+				}
+			}
+			if !slices.Equal(gotDecisions, tt.wantDecisions) {
+				t.Errorf("cached decisions for %v, want %v", gotDecisions, tt.wantDecisions)
+			}
+			if !slices.Equal(gotGrants, tt.wantGrants) {
+				t.Errorf("cached grants for %v, want %v", gotGrants, tt.wantGrants)
+			}
+		})
+	}
+}
+
+func TestAccessCheckClient_InvalidationDuringRequest(t *testing.T) {
+	// The tuple change event lands after the request was sent but before
+	// fga-sync replied, so the reply may predate the change.
+	var client *AccessCheckClient
+	requests := 0
+	changed := domain.Tuple{Object: "project:abc", Relation: "viewer", User: "user:alice"}
+	client = NewAccessCheckClient(&mockMessagingRepository{
+		requestFunc: func(_ context.Context, subject string, data []byte, _ time.Duration) ([]byte, error) {
+			requests++
+			client.InvalidateTuples(context.Background(), []domain.Tuple{changed})
+			if subject == constants.ReadTuplesSubject {
+				return json.Marshal(readTuplesResponse{Results: []string{changed.String()}})
+			}
+			return projectGrantReply(data), nil
+		},
+	})
+	client.EnableDecisionCache(100, time.Minute)
+	client.EnableGrantsCache(100, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.CheckAccess(context.Background(), []domain.Tuple{changed}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := client.ReadTuples(context.Background(), ReadTuplesQuery{User: "user:alice", ObjectTypes: []string{"project"}}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if requests != 4 {
+		t.Errorf("expected replies that raced an invalidation not to be cached, got %d requests; want 4", requests)
+	}
+	if n := client.decisions.Len() + client.grants.Len(); n != 0 {
+		t.Errorf("expected nothing cached, got %d entries", n)
+	}
+}
+
+func TestAccessCheckClient_SubscribeTupleChanges(t *testing.T) {
+	var (
+		subscribed string
+		handler    contracts.MessageHandler
+	)
+	requests := 0
+	client := newCachingTestClient(&requests)
+	client.messagingRepo.(*mockMessagingRepository).subscribeFunc = func(subject string, h contracts.MessageHandler) error {
+		subscribed, handler = subject, h
+		return nil
+	}
+
+	if err := client.SubscribeTupleChanges("test.tuples_changed"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if subscribed != "test.tuples_changed" || handler == nil {
+		t.Fatalf("expected subscription on test.tuples_changed, got %q", subscribed)
+	}
+
+	checks := aliceChecks(t, "project:abc#viewer", "committee:xyz#writer")
+	warm := func() {
+		t.Helper()
+		if _, err := client.CheckAccess(context.Background(), checks); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	warm()
+	handler(context.Background(), subscribed, []byte(`{"operation":"write","tuples":["committee:xyz#writer@user:alice"]}`))
+	if n := decisionCacheStats(client).Len; n != 0 {
+		t.Errorf("expected alice's decisions to be evicted, %d remain", n)
+	}
+
+	warm()
+	handler(context.Background(), subscribed, []byte("not json"))
+	if n := decisionCacheStats(client).Len; n != 0 {
+		t.Errorf("expected unreadable event to purge decisions, %d remain", n)
+	}
+}
+
+// ----- Chunking -----
+
+// manyChecks returns n distinct check tuples for user:alice.
+func manyChecks(t *testing.T, n int) []domain.Tuple {
+	t.Helper()
+	requests := make([]string, n)
+	for i := range requests {
+		requests[i] = fmt.Sprintf("project:p%d#viewer", i)
+	}
+	return aliceChecks(t, requests...)
+}
+
+func TestAccessCheckClient_CheckAccess_Chunking(t *testing.T) {
+	var (
+		mu                  sync.Mutex
+		sizes               []int
+		inFlight, maxFlight int
+	)
+	client := newTestClient(func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+		mu.Lock()
+		inFlight++
+		maxFlight = max(maxFlight, inFlight)
+		sizes = append(sizes, strings.Count(string(data), "\n")+1)
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		return projectGrantReply(data), nil
+	})
+	client.SetChunking(100, 2)
+
+	checks := manyChecks(t, 250)
+	// A duplicate must be answered without being sent twice.
+	checks = append(checks, checks[0])
+
+	results, err := client.CheckAccess(context.Background(), checks)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	slices.Sort(sizes)
+	if !slices.Equal(sizes, []int{50, 100, 100}) {
+		t.Errorf("expected chunks of 100, 100 and 50 tuples, got %v", sizes)
+	}
+	if maxFlight > 2 {
+		t.Errorf("expected at most 2 chunks in flight, got %d", maxFlight)
+	}
+	if len(results) != len(checks) {
+		t.Fatalf("expected %d results, got %d", len(checks), len(results))
+	}
+	for i, result := range results {
+		if result.Tuple != checks[i] || !result.Allowed {
+			t.Errorf("result[%d]: expected allowed %v, got %v", i, checks[i], result)
+		}
+	}
+}
+
+func TestAccessCheckClient_CheckAccess_ChunkFailure(t *testing.T) {
+	upstreamErr := errors.New("nats: timeout")
+	client := newTestClient(func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+		if strings.HasPrefix(string(data), "project:p100#") {
+			return nil, upstreamErr
+		}
+		return projectGrantReply(data), nil
+	})
+	client.SetChunking(100, 1)
+
+	results, err := client.CheckAccess(context.Background(), manyChecks(t, 250))
+	if !errors.Is(err, upstreamErr) {
+		t.Fatalf("expected upstream error, got %v", err)
+	}
+	if results != nil {
+		t.Errorf("expected no partial results, got %d", len(results))
+	}
+}
+
+func TestAccessCheckClient_CheckAccessWithContext_ChunksCarryContext(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		payloads []contextualCheckRequest
+	)
+	client := newTestClient(func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+		var req contextualCheckRequest
+		if err := json.Unmarshal(data, &req); err != nil {
+			return nil, err
+		}
+		mu.Lock()
+		payloads = append(payloads, req)
+		mu.Unlock()
+		return projectGrantReply([]byte(strings.Join(req.Checks, "\n"))), nil
+	})
+	client.SetChunking(2, 4)
+
+	_, err := client.CheckAccessWithContext(context.Background(), manyChecks(t, 3), domain.CheckContext{
+		Context: map[string]any{"ip": "10.0.0.1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(payloads) != 2 {
+		t.Fatalf("expected 2 chunks, got %d", len(payloads))
+	}
+	for i, p := range payloads {
+		if p.Context["ip"] != "10.0.0.1" {
+			t.Errorf("chunk %d: expected condition context, got %v", i, p.Context)
+		}
+	}
+}
+
 // ----- ReadTuples -----
 
 func TestAccessCheckClient_ReadTuples_Success(t *testing.T) {
@@ -101,12 +935,92 @@ func TestAccessCheckClient_ReadTuples_Success(t *testing.T) {
 		return []byte(`{"results":["project:abc#auditor@user:alice","committee:xyz#writer@user:alice"]}`), nil
 	})
 
-	results, err := client.ReadTuples(context.Background(), "alice", "project")
+	page, err := client.ReadTuples(context.Background(), ReadTuplesQuery{User: "user:alice", ObjectTypes: []string{"project", "committee"}})
+	if err != nil {
+		t.Fatalf("ReadTuples failed: %v", err)
+	}
+	if len(page.Tuples) != 2 {
+		t.Errorf("expected 2 results, got %d", len(page.Tuples))
+	}
+	if page.ContinuationToken != "" {
+		t.Errorf("expected no continuation token, got %q", page.ContinuationToken)
+	}
+}
+
+func TestAccessCheckClient_ReadTuples_Pagination(t *testing.T) {
+	client := newTestClient(func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+		var req readTuplesRequest
+		if err := json.Unmarshal(data, &req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		expected := readTuplesRequest{User: "user:alice", ObjectType: "project", PageSize: 1, ContinuationToken: "page-1"}
+		if !reflect.DeepEqual(req, expected) {
+			t.Errorf("expected request %+v, got %+v", expected, req)
+		}
+		return []byte(`{"results":["project:abc#auditor@user:alice"],"continuation_token":"page-2"}`), nil
+	})
+
+	page, err := client.ReadTuples(context.Background(), ReadTuplesQuery{
+		User:              "user:alice",
+		ObjectTypes:       []string{"project"},
+		PageSize:          1,
+		ContinuationToken: "page-1",
+	})
+	if err != nil {
+		t.Fatalf("ReadTuples failed: %v", err)
+	}
+	if len(page.Tuples) != 1 {
+		t.Errorf("expected 1 result, got %d", len(page.Tuples))
+	}
+	if page.ContinuationToken != "page-2" {
+		t.Errorf("expected continuation token %q, got %q", "page-2", page.ContinuationToken)
+	}
+}
+
+func TestAccessCheckClient_ReadTuples_MultipleTypesAndRelation(t *testing.T) {
+	client := newTestClient(func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+		var req readTuplesRequest
+		if err := json.Unmarshal(data, &req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		expected := readTuplesRequest{User: "user:alice", ObjectTypes: []string{"project", "committee"}, Relation: "writer"}
+		if !reflect.DeepEqual(req, expected) {
+			t.Errorf("expected request %+v, got %+v", expected, req)
+		}
+		// The responder ignores the filters; the client must apply them.
+		return []byte(`{"results":["project:abc#writer@user:alice","project:abc#auditor@user:alice","committee:xyz#writer@user:alice","meeting:m1#writer@user:alice"]}`), nil
+	})
+
+	page, err := client.ReadTuples(context.Background(), ReadTuplesQuery{
+		User:        "user:alice",
+		ObjectTypes: []string{"project", "committee"},
+		Relation:    "writer",
+	})
+	if err != nil {
+		t.Fatalf("ReadTuples failed: %v", err)
+	}
+	expected := []domain.Tuple{
+		{Object: "project:abc", Relation: "writer", User: "user:alice"},
+		{Object: "committee:xyz", Relation: "writer", User: "user:alice"},
+	}
+	if !slices.Equal(page.Tuples, expected) {
+		t.Errorf("expected %v, got %v", expected, page.Tuples)
+	}
+}
+
+func TestAccessCheckClient_ReadTuples_OversizedPage(t *testing.T) {
+	client := newTestClient(func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+		return []byte(`{"results":["project:abc#auditor@user:alice","project:xyz#writer@user:alice"]}`), nil
+	})
+
+	// A responder that predates paging ignores page_size; its reply is
+	// passed through whole.
+	page, err := client.ReadTuples(context.Background(), ReadTuplesQuery{User: "user:alice", ObjectTypes: []string{"project"}, PageSize: 1})
 	if err != nil {
 		t.Fatalf("ReadTuples failed: %v", err)
 	}
-	if len(results) != 2 {
-		t.Errorf("expected 2 results, got %d", len(results))
+	if len(page.Tuples) != 2 || page.ContinuationToken != "" {
+		t.Errorf("expected both tuples in a final page, got %v (token %q)", page.Tuples, page.ContinuationToken)
 	}
 }
 
@@ -115,15 +1029,15 @@ func TestAccessCheckClient_ReadTuples_NilResultsNormalized(t *testing.T) {
 		return []byte(`{}`), nil
 	})
 
-	results, err := client.ReadTuples(context.Background(), "alice", "project")
+	page, err := client.ReadTuples(context.Background(), ReadTuplesQuery{User: "user:alice", ObjectTypes: []string{"project"}})
 	if err != nil {
 		t.Fatalf("ReadTuples failed: %v", err)
 	}
-	if results == nil {
+	if page.Tuples == nil {
 		t.Error("expected empty slice, got nil")
 	}
-	if len(results) != 0 {
-		t.Errorf("expected 0 results, got %d", len(results))
+	if len(page.Tuples) != 0 {
+		t.Errorf("expected 0 results, got %d", len(page.Tuples))
 	}
 }
 
@@ -132,7 +1046,7 @@ func TestAccessCheckClient_ReadTuples_NATSFailure(t *testing.T) {
 		return nil, errors.New("nats timeout")
 	})
 
-	_, err := client.ReadTuples(context.Background(), "alice", "project")
+	_, err := client.ReadTuples(context.Background(), ReadTuplesQuery{User: "user:alice", ObjectTypes: []string{"project"}})
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -146,7 +1060,7 @@ func TestAccessCheckClient_ReadTuples_UnmarshalFailure(t *testing.T) {
 		return []byte(`not valid json`), nil
 	})
 
-	_, err := client.ReadTuples(context.Background(), "alice", "project")
+	_, err := client.ReadTuples(context.Background(), ReadTuplesQuery{User: "user:alice", ObjectTypes: []string{"project"}})
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -160,7 +1074,7 @@ func TestAccessCheckClient_ReadTuples_BackendError(t *testing.T) {
 		return []byte(`{"error":"store not found"}`), nil
 	})
 
-	_, err := client.ReadTuples(context.Background(), "alice", "project")
+	_, err := client.ReadTuples(context.Background(), ReadTuplesQuery{User: "user:alice", ObjectTypes: []string{"project"}})
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -172,6 +1086,148 @@ func TestAccessCheckClient_ReadTuples_BackendError(t *testing.T) {
 	}
 }
 
+// ----- ListObjects -----
+
+func TestAccessCheckClient_ListObjects_Success(t *testing.T) {
+	client := newTestClient(func(_ context.Context, subject string, data []byte, _ time.Duration) ([]byte, error) {
+		if subject != constants.ListObjectsSubject {
+			t.Errorf("unexpected NATS subject: %s", subject)
+		}
+		var req listObjectsRequest
+		if err := json.Unmarshal(data, &req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		expected := listObjectsRequest{User: "user:alice", ObjectType: "project", Relation: "viewer"}
+		if req != expected {
+			t.Errorf("expected request %+v, got %+v", expected, req)
+		}
+		return []byte(`{"results":["project:abc","project:xyz"]}`), nil
+	})
+
+	objects, err := client.ListObjects(context.Background(), "user:alice", "project", "viewer")
+	if err != nil {
+		t.Fatalf("ListObjects failed: %v", err)
+	}
+	expected := []string{"project:abc", "project:xyz"}
+	if !slices.Equal(objects, expected) {
+		t.Errorf("expected %v, got %v", expected, objects)
+	}
+}
+
+func TestAccessCheckClient_ListObjects_NilResultsNormalized(t *testing.T) {
+	client := newTestClient(func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+		return []byte(`{}`), nil
+	})
+
+	objects, err := client.ListObjects(context.Background(), "user:alice", "project", "viewer")
+	if err != nil {
+		t.Fatalf("ListObjects failed: %v", err)
+	}
+	if objects == nil || len(objects) != 0 {
+		t.Errorf("expected empty slice, got %#v", objects)
+	}
+}
+
+func TestAccessCheckClient_ListObjects_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		reply      []byte
+		replyErr   error
+		wantErrIs  error
+		wantErrMsg string
+	}{
+		{name: "NATS failure", replyErr: errors.New("nats timeout"), wantErrMsg: "NATS request to subject"},
+		{name: "invalid JSON", reply: []byte(`not valid json`), wantErrIs: constants.ErrUnexpectedResponse},
+		{name: "backend error", reply: []byte(`{"error":"store not found"}`), wantErrMsg: "FGA error"},
+		{name: "wrong object type", reply: []byte(`{"results":["committee:abc"]}`), wantErrIs: constants.ErrUnexpectedResponse},
+		{name: "missing object id", reply: []byte(`{"results":["project:"]}`), wantErrIs: constants.ErrUnexpectedResponse},
+		{name: "bare object type", reply: []byte(`{"results":["project"]}`), wantErrIs: constants.ErrUnexpectedResponse},
+		{name: "malformed object id", reply: []byte(`{"results":["project:abc#viewer"]}`), wantErrIs: constants.ErrUnexpectedResponse},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+				return tc.reply, tc.replyErr
+			})
+
+			_, err := client.ListObjects(context.Background(), "user:alice", "project", "viewer")
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if tc.wantErrIs != nil && !errors.Is(err, tc.wantErrIs) {
+				t.Errorf("expected %v, got %v", tc.wantErrIs, err)
+			}
+			if tc.wantErrMsg != "" && !strings.Contains(err.Error(), tc.wantErrMsg) {
+				t.Errorf("expected error containing %q, got %v", tc.wantErrMsg, err)
+			}
+		})
+	}
+}
+
+// ----- ListUsers -----
+
+func TestAccessCheckClient_ListUsers_Success(t *testing.T) {
+	client := newTestClient(func(_ context.Context, subject string, data []byte, _ time.Duration) ([]byte, error) {
+		if subject != constants.ListUsersSubject {
+			t.Errorf("unexpected NATS subject: %s", subject)
+		}
+		var req listUsersRequest
+		if err := json.Unmarshal(data, &req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		expected := listUsersRequest{Object: "committee:xyz", Relation: "writer"}
+		if req != expected {
+			t.Errorf("expected request %+v, got %+v", expected, req)
+		}
+		return []byte(`{"results":["user:auth0|alice","project:abc#writer"]}`), nil
+	})
+
+	users, err := client.ListUsers(context.Background(), "committee:xyz", "writer")
+	if err != nil {
+		t.Fatalf("ListUsers failed: %v", err)
+	}
+	expected := []string{"user:auth0|alice", "project:abc#writer"}
+	if !slices.Equal(users, expected) {
+		t.Errorf("expected %v, got %v", expected, users)
+	}
+}
+
+func TestAccessCheckClient_ListUsers_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		reply      []byte
+		replyErr   error
+		wantErrIs  error
+		wantErrMsg string
+	}{
+		{name: "NATS failure", replyErr: errors.New("nats timeout"), wantErrMsg: "NATS request to subject"},
+		{name: "invalid JSON", reply: []byte(`not valid json`), wantErrIs: constants.ErrUnexpectedResponse},
+		{name: "backend error", reply: []byte(`{"error":"store not found"}`), wantErrMsg: "FGA error"},
+		{name: "untyped user", reply: []byte(`{"results":["alice"]}`), wantErrIs: constants.ErrUnexpectedResponse},
+		{name: "empty user", reply: []byte(`{"results":[""]}`), wantErrIs: constants.ErrUnexpectedResponse},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+				return tc.reply, tc.replyErr
+			})
+
+			_, err := client.ListUsers(context.Background(), "committee:xyz", "writer")
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if tc.wantErrIs != nil && !errors.Is(err, tc.wantErrIs) {
+				t.Errorf("expected %v, got %v", tc.wantErrIs, err)
+			}
+			if tc.wantErrMsg != "" && !strings.Contains(err.Error(), tc.wantErrMsg) {
+				t.Errorf("expected error containing %q, got %v", tc.wantErrMsg, err)
+			}
+		})
+	}
+}
+
 // ----- HealthCheck -----
 
 func TestAccessCheckClient_HealthCheck_NilRepo(t *testing.T) {
@@ -199,47 +1255,39 @@ func TestAccessCheckClient_HealthCheck_Healthy(t *testing.T) {
 func TestAccessCheckClient_BuildMessage(t *testing.T) {
 	client := NewAccessCheckClient(&mockMessagingRepository{})
 
+	repo1 := domain.Tuple{Object: "repo:one", Relation: "viewer", User: "user:user1"}
+	repo2 := domain.Tuple{Object: "repo:two", Relation: "viewer", User: "user:user1"}
+
 	tests := []struct {
-		name      string
-		principal string
-		resources []string
-		expected  string
+		name     string
+		checks   []domain.Tuple
+		expected string
 	}{
 		{
-			name:      "empty resources",
-			principal: "user1",
-			resources: []string{},
-			expected:  "",
+			name:     "empty checks",
+			checks:   []domain.Tuple{},
+			expected: "",
 		},
 		{
-			name:      "single resource",
-			principal: "user1",
-			resources: []string{"repo1"},
-			expected:  "repo1@user:user1",
+			name:     "single check",
+			checks:   []domain.Tuple{repo1},
+			expected: "repo:one#viewer@user:user1",
 		},
 		{
-			name:      "multiple resources",
-			principal: "user1",
-			resources: []string{"repo1", "repo2"},
-			expected:  "repo1@user:user1\nrepo2@user:user1",
+			name:     "multiple checks",
+			checks:   []domain.Tuple{repo1, repo2},
+			expected: "repo:one#viewer@user:user1\nrepo:two#viewer@user:user1",
 		},
 		{
-			name:      "empty resource filtered out",
-			principal: "user1",
-			resources: []string{"repo1", "", "repo2"},
-			expected:  "repo1@user:user1\nrepo2@user:user1",
-		},
-		{
-			name:      "all empty resources",
-			principal: "user1",
-			resources: []string{"", "", ""},
-			expected:  "",
+			name:     "duplicate check sent once",
+			checks:   []domain.Tuple{repo1, repo2, repo1},
+			expected: "repo:one#viewer@user:user1\nrepo:two#viewer@user:user1",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			result := client.buildMessage(tc.principal, tc.resources)
+			result := client.buildMessage(tc.checks)
 			if result != tc.expected {
 				t.Errorf("expected %q, got %q", tc.expected, result)
 			}
@@ -252,32 +1300,40 @@ func TestAccessCheckClient_BuildMessage(t *testing.T) {
 func TestAccessCheckClient_ParseResponse(t *testing.T) {
 	client := NewAccessCheckClient(&mockMessagingRepository{})
 
+	viewer := domain.Tuple{Object: "project:abc", Relation: "viewer", User: "user:alice"}
+	writer := domain.Tuple{Object: "committee:xyz", Relation: "writer", User: "user:alice"}
+
 	tests := []struct {
 		name         string
 		responseData []byte
-		expected     []string
+		expected     []domain.CheckResult
 		expectError  bool
 	}{
 		{
 			name:         "valid response",
-			responseData: []byte("true\nfalse\ntrue"),
-			expected:     []string{"true", "false", "true"},
+			responseData: []byte("project:abc#viewer@user:alice\ttrue\ncommittee:xyz#writer@user:alice\tfalse"),
+			expected:     []domain.CheckResult{{Tuple: viewer, Allowed: true}, {Tuple: writer, Allowed: false}},
 		},
 		{
 			name:         "empty response",
 			responseData: []byte(""),
-			expected:     []string{},
+			expected:     []domain.CheckResult{},
 		},
 		{
 			name:         "response with empty lines",
-			responseData: []byte("true\n\nfalse\n"),
-			expected:     []string{"true", "false"},
+			responseData: []byte("project:abc#viewer@user:alice\ttrue\n\ncommittee:xyz#writer@user:alice\tfalse\n"),
+			expected:     []domain.CheckResult{{Tuple: viewer, Allowed: true}, {Tuple: writer, Allowed: false}},
 		},
 		{
 			name:         "response with spaces triggers error",
 			responseData: []byte("error message here"),
 			expectError:  true,
 		},
+		{
+			name:         "bare decisions trigger error",
+			responseData: []byte("true\nfalse"),
+			expectError:  true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -288,6 +1344,9 @@ func TestAccessCheckClient_ParseResponse(t *testing.T) {
 				if err == nil {
 					t.Fatal("expected error but got none")
 				}
+				if !errors.Is(err, constants.ErrUnexpectedResponse) {
+					t.Errorf("expected ErrUnexpectedResponse, got %v", err)
+				}
 				return
 			}
 
@@ -299,7 +1358,7 @@ func TestAccessCheckClient_ParseResponse(t *testing.T) {
 			}
 			for i, want := range tc.expected {
 				if result[i] != want {
-					t.Errorf("result[%d]: expected %q, got %q", i, want, result[i])
+					t.Errorf("result[%d]: expected %+v, got %+v", i, want, result[i])
 				}
 			}
 		})
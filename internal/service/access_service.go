@@ -9,10 +9,16 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	accesssvc "github.com/linuxfoundation/lfx-v2-access-check/gen/access_svc"
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/middleware"
 	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
 	"goa.design/goa/v3/security"
 )
@@ -23,14 +29,139 @@ import (
 type AccessService struct {
 	authRepo contracts.AuthRepository
 	client   *AccessCheckClient
+
+	// listUsersRelation is the relation a caller must hold on an object to
+	// list its users.
+	listUsersRelation string
+
+	// permissionModel lists the relations MyPermissions checks per object type.
+	permissionModel domain.PermissionModel
+
+	// contextualTupleClients lists the client IDs allowed to send contextual
+	// tuples; empty refuses them from everyone.
+	contextualTupleClients []string
+
+	// rateLimiter limits the requests of each caller; nil disables it.
+	rateLimiter *RateLimiter
+
+	// tokenCache serves the claims of recently validated tokens; nil
+	// disables it.
+	tokenCache *tokenCache
+}
+
+// Option configures optional AccessService behaviour.
+type Option func(*AccessService)
+
+// WithListUsersRelation sets the relation a caller must hold on an object to
+// list its users. An empty relation keeps constants.DefaultListUsersRelation.
+func WithListUsersRelation(relation string) Option {
+	return func(s *AccessService) {
+		if relation != "" {
+			s.listUsersRelation = relation
+		}
+	}
+}
+
+// WithPermissionModel sets the model MyPermissions uses to decide which
+// relations to check. It defaults to domain.DefaultPermissionModel.
+func WithPermissionModel(model domain.PermissionModel) Option {
+	return func(s *AccessService) {
+		if len(model) > 0 {
+			s.permissionModel = model
+		}
+	}
+}
+
+// WithContextualTupleClients allows callers whose token names one of clients
+// as its client ID to send contextual tuples and condition context. Without
+// it, calls with either are refused with 403 Forbidden.
+func WithContextualTupleClients(clients []string) Option {
+	return func(s *AccessService) {
+		s.contextualTupleClients = clients
+	}
+}
+
+// WithDecisionCache caches up to size access-check decisions for ttl each in
+// front of NATS. A non-positive size or ttl leaves the cache disabled.
+func WithDecisionCache(size int, ttl time.Duration) Option {
+	return func(s *AccessService) {
+		s.client.EnableDecisionCache(size, ttl)
+	}
+}
+
+// WithGrantsCache caches up to size my-grants pages for ttl each. A
+// non-positive size or ttl leaves the cache disabled.
+func WithGrantsCache(size int, ttl time.Duration) Option {
+	return func(s *AccessService) {
+		s.client.EnableGrantsCache(size, ttl)
+	}
+}
+
+// WithTokenCache caches the claims of up to size validated tokens, each until
+// the token expires but for at most maxTTL. A non-positive size or maxTTL
+// leaves the cache disabled.
+func WithTokenCache(size int, maxTTL time.Duration) Option {
+	return func(s *AccessService) {
+		s.tokenCache = newTokenCache(size, maxTTL)
+	}
+}
+
+// WithChunking caps the tuples per access-check message at chunkSize and the
+// chunks of one call sent in parallel at parallelism. Non-positive values keep
+// constants.DefaultCheckChunkSize and constants.DefaultCheckChunkParallelism.
+func WithChunking(chunkSize int, parallelism int) Option {
+	return func(s *AccessService) {
+		s.client.SetChunking(chunkSize, parallelism)
+	}
+}
+
+// WithBatching merges the checks of concurrent callers into shared
+// access-check messages of at most maxSize tuples, collected for window. Each
+// message waits no longer than its callers' latest deadline, capped at
+// maxTimeout. A non-positive window or maxSize leaves batching disabled.
+func WithBatching(window time.Duration, maxSize int, maxTimeout time.Duration) Option {
+	return func(s *AccessService) {
+		s.client.EnableBatching(window, maxSize, maxTimeout)
+	}
+}
+
+// WithDegradedMode serves cached decisions up to maxStaleness old, marked
+// stale, when fga-sync is unavailable. Uncached checks are denied when
+// failClosed is set and fail the call otherwise. It needs the decision cache;
+// a non-positive maxStaleness leaves it disabled.
+func WithDegradedMode(maxStaleness time.Duration, failClosed bool) Option {
+	return func(s *AccessService) {
+		s.client.EnableDegradedMode(maxStaleness, failClosed)
+	}
+}
+
+// WithRateLimiter limits the requests of each authenticated caller with
+// limiter. Callers over their limit get 429 Too Many Requests. A nil limiter
+// leaves rate limiting disabled.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(s *AccessService) {
+		s.rateLimiter = limiter
+	}
 }
 
 // NewAccessService creates a new AccessService wired to the given repositories.
-func NewAccessService(authRepo contracts.AuthRepository, messagingRepo contracts.MessagingRepository) *AccessService {
-	return &AccessService{
-		authRepo: authRepo,
-		client:   NewAccessCheckClient(messagingRepo),
+func NewAccessService(authRepo contracts.AuthRepository, messagingRepo contracts.MessagingRepository, opts ...Option) *AccessService {
+	s := &AccessService{
+		authRepo:          authRepo,
+		client:            NewAccessCheckClient(messagingRepo),
+		listUsersRelation: constants.DefaultListUsersRelation,
+		permissionModel:   domain.DefaultPermissionModel(),
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
+}
+
+// SubscribeTupleChanges evicts cached decisions and grants whenever fga-sync
+// publishes a tuple change on subject.
+func (s *AccessService) SubscribeTupleChanges(subject string) error {
+	return s.client.SubscribeTupleChanges(subject)
 }
 
 // Verify interface compliance at compile time.
@@ -47,15 +178,146 @@ func claimsFromContext(ctx context.Context) (*contracts.HeimdallClaims, bool) {
 	return claims, ok
 }
 
-// requireAPIVersion returns a non-nil error when version does not match the
-// single supported API version. Callers wrap the result in accesssvc.MakeBadRequest.
-func requireAPIVersion(version string) error {
-	if version != constants.SupportedAPIVersion {
+// requireAPIVersion returns a non-nil error when version does not match any of
+// the API versions supported by the calling method. Callers wrap the result in
+// accesssvc.MakeBadRequest.
+func requireAPIVersion(version string, supported ...string) error {
+	if !slices.Contains(supported, version) {
 		return fmt.Errorf("%s: %s", constants.ErrMsgUnsupportedAPIVersion, version)
 	}
 	return nil
 }
 
+// parseCheckRequests parses every "type:id#relation" request entry into a
+// tuple checked for user. Invalid entries are collected into a single
+// ErrInvalidAccessRequest that names the index and reason of each one.
+func parseCheckRequests(requests []string, user string) ([]domain.Tuple, error) {
+	checks := make([]domain.Tuple, 0, len(requests))
+	var problems []string
+	for i, request := range requests {
+		t, err := domain.ParseObjectRelation(request)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("requests[%d]: %v", i, err))
+			continue
+		}
+		checks = append(checks, t.WithUser(user))
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("%w: %s", constants.ErrInvalidAccessRequest, strings.Join(problems, "; "))
+	}
+	return checks, nil
+}
+
+// toCheckContext converts the payload's contextual tuples and condition
+// context into a domain.CheckContext.
+func toCheckContext(p *accesssvc.CheckAccessPayload) domain.CheckContext {
+	checkCtx := domain.CheckContext{Context: p.Context}
+	for _, t := range p.ContextualTuples {
+		if t == nil {
+			continue
+		}
+		checkCtx.ContextualTuples = append(checkCtx.ContextualTuples, domain.Tuple{
+			Object:   t.Object,
+			Relation: t.Relation,
+			User:     t.User,
+		})
+	}
+	return checkCtx
+}
+
+// toResultStrings formats check results as v1 "object#relation@user\t{true|false}" lines.
+func toResultStrings(results []domain.CheckResult) []string {
+	lines := make([]string, 0, len(results))
+	for _, result := range results {
+		lines = append(lines, result.String())
+	}
+	return lines
+}
+
+// toAccessCheckDecisions converts check results into structured v2 decisions.
+func toAccessCheckDecisions(results []domain.CheckResult) []*accesssvc.AccessCheckDecision {
+	decisions := make([]*accesssvc.AccessCheckDecision, 0, len(results))
+	for _, result := range results {
+		decision := &accesssvc.AccessCheckDecision{
+			Object:   result.Object,
+			Relation: result.Relation,
+			User:     result.User,
+			Allowed:  result.Allowed,
+		}
+		if result.Stale {
+			decision.Stale = &result.Stale
+		}
+		if result.Contextual {
+			decision.Contextual = &result.Contextual
+		}
+		decisions = append(decisions, decision)
+	}
+	return decisions
+}
+
+// markResults sets the stale response header when any result was served in
+// degraded mode, and the contextual response header when any result was made
+// with contextual tuples.
+func markResults(ctx context.Context, results []domain.CheckResult) {
+	if slices.ContainsFunc(results, func(r domain.CheckResult) bool { return r.Stale }) {
+		middleware.SetResponseHeader(ctx, constants.StaleResponseHeader, "true")
+	}
+	if slices.ContainsFunc(results, func(r domain.CheckResult) bool { return r.Contextual }) {
+		middleware.SetResponseHeader(ctx, constants.ContextualResponseHeader, "true")
+	}
+}
+
+// allowsCheckContext reports whether claims name a client ID allowed to send
+// contextual tuples and condition context. Audiences are not considered:
+// Heimdall gives every token it issues the same audience.
+func (s *AccessService) allowsCheckContext(claims *contracts.HeimdallClaims) bool {
+	return claims.ClientID != "" && slices.Contains(s.contextualTupleClients, claims.ClientID)
+}
+
+// groupGrantsByType formats tuples as "object#relation@user" strings grouped by
+// object type. grants lists the groups in objectTypes order; grantsByType has
+// an entry, possibly empty, for every requested type.
+func groupGrantsByType(tuples []domain.Tuple, objectTypes []string) (grants []string, grantsByType map[string][]string) {
+	grantsByType = make(map[string][]string, len(objectTypes))
+	for _, objectType := range objectTypes {
+		grantsByType[objectType] = []string{}
+	}
+	for _, t := range tuples {
+		objectType := t.ObjectType()
+		grantsByType[objectType] = append(grantsByType[objectType], t.String())
+	}
+
+	grants = make([]string, 0, len(tuples))
+	for _, objectType := range objectTypes {
+		grants = append(grants, grantsByType[objectType]...)
+	}
+	return grants, grantsByType
+}
+
+// uniqueStrings returns values without duplicates, keeping first-seen order.
+func uniqueStrings(values []string) []string {
+	unique := make([]string, 0, len(values))
+	for _, v := range values {
+		if !slices.Contains(unique, v) {
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
+
+// splitUsersets separates usersets ("team:xyz#member") from single users.
+func splitUsersets(entries []string) (users, usersets []string) {
+	users, usersets = []string{}, []string{}
+	for _, entry := range entries {
+		if domain.IsUserset(entry) {
+			usersets = append(usersets, entry)
+		} else {
+			users = append(users, entry)
+		}
+	}
+	return users, usersets
+}
+
 // ===== GOA Authentication Interface =====
 
 // JWTAuth implements the authorization logic for the JWT security scheme.
@@ -64,7 +326,7 @@ func (s *AccessService) JWTAuth(ctx context.Context, token string, _ *security.J
 		token = after
 	}
 
-	claims, err := s.authRepo.ValidateToken(ctx, token)
+	claims, err := s.validateToken(ctx, token)
 	if err != nil {
 		slog.ErrorContext(ctx, "JWT validation failed", "error", err)
 		if errors.Is(err, constants.ErrUnexpectedResponse) {
@@ -75,44 +337,111 @@ func (s *AccessService) JWTAuth(ctx context.Context, token string, _ *security.J
 
 	ctx = context.WithValue(ctx, constants.ClaimsContextKey, claims)
 	slog.DebugContext(ctx, "JWT validation successful", "principal", claims.Principal)
+
+	if err := s.enforceRateLimit(ctx, claims); err != nil {
+		return nil, err
+	}
 	return ctx, nil
 }
 
+// validateToken validates token, using the token cache when it is enabled.
+func (s *AccessService) validateToken(ctx context.Context, token string) (*contracts.HeimdallClaims, error) {
+	if s.tokenCache == nil {
+		return s.authRepo.ValidateToken(ctx, token)
+	}
+	return s.tokenCache.validate(ctx, s.authRepo, token)
+}
+
+// enforceRateLimit spends one request of the caller's rate limit, reporting
+// the limit in RateLimit-* response headers, and fails with 429 when the
+// caller is over it.
+func (s *AccessService) enforceRateLimit(ctx context.Context, claims *contracts.HeimdallClaims) error {
+	if s.rateLimiter == nil {
+		return nil
+	}
+	decision, limited := s.rateLimiter.Take(ctx, claims)
+	if !limited {
+		return nil
+	}
+
+	middleware.SetResponseHeader(ctx, constants.RateLimitLimitHeader, strconv.Itoa(decision.Limit))
+	middleware.SetResponseHeader(ctx, constants.RateLimitRemainingHeader, strconv.Itoa(decision.Remaining))
+	middleware.SetResponseHeader(ctx, constants.RateLimitResetHeader, strconv.Itoa(ceilSeconds(decision.Reset)))
+	if decision.Allowed {
+		return nil
+	}
+
+	slog.WarnContext(ctx, "Rate limit exceeded", "principal", claims.Principal, "retry_after", decision.RetryAfter)
+	middleware.SetResponseHeader(ctx, constants.RetryAfterHeader, strconv.Itoa(max(ceilSeconds(decision.RetryAfter), 1)))
+	return accesssvc.MakeTooManyRequests(constants.ErrRateLimited)
+}
+
 // ===== GOA Service Interface =====
 
-// CheckAccess validates the request and delegates to AccessCheckClient.
-func (s *AccessService) CheckAccess(ctx context.Context, p *accesssvc.CheckAccessPayload) (*accesssvc.CheckAccessResult, error) {
+// CheckAccess validates the request and delegates to AccessCheckClient. The
+// returned view renders v1 tuple-strings or v2 structured decisions.
+func (s *AccessService) CheckAccess(ctx context.Context, p *accesssvc.CheckAccessPayload) (*accesssvc.CheckAccessResult, string, error) {
 	claims, ok := claimsFromContext(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "Failed to get claims from context")
-		return nil, accesssvc.MakeUnauthorized(constants.ErrInvalidAuthContext)
+		return nil, "", accesssvc.MakeUnauthorized(constants.ErrInvalidAuthContext)
 	}
 
-	if err := requireAPIVersion(p.Version); err != nil {
+	if err := requireAPIVersion(p.Version, constants.SupportedAPIVersion, constants.StructuredResultsAPIVersion); err != nil {
 		slog.WarnContext(ctx, "Unsupported API version", "version", p.Version)
-		return nil, accesssvc.MakeBadRequest(err)
+		return nil, "", accesssvc.MakeBadRequest(err)
 	}
 
 	if len(p.Requests) == 0 {
 		slog.WarnContext(ctx, "Empty requests array")
-		return &accesssvc.CheckAccessResult{Results: []string{}}, nil
+		if p.Version == constants.StructuredResultsAPIVersion {
+			return &accesssvc.CheckAccessResult{Decisions: []*accesssvc.AccessCheckDecision{}}, constants.StructuredResultsView, nil
+		}
+		return &accesssvc.CheckAccessResult{Results: []string{}}, constants.CheckAccessResultView, nil
+	}
+
+	// Condition context is gated like contextual tuples: a caller able to set
+	// its own current time or client IP could satisfy conditional grants it
+	// does not hold.
+	checkCtx := toCheckContext(p)
+	if !checkCtx.IsZero() && !s.allowsCheckContext(claims) {
+		slog.WarnContext(ctx, "Check context refused", "principal", claims.Principal, "client_id", claims.ClientID)
+		return nil, "", accesssvc.MakeForbidden(constants.ErrContextualForbidden)
+	}
+
+	user, err := domain.NewUser(claims.Principal)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid principal for access check", "error", err, "principal", claims.Principal)
+		return nil, "", accesssvc.MakeUnauthorized(err)
+	}
+
+	checks, err := parseCheckRequests(p.Requests, user)
+	if err != nil {
+		slog.WarnContext(ctx, "Invalid access check requests", "error", err, "principal", claims.Principal)
+		return nil, "", accesssvc.MakeBadRequest(err)
 	}
 
-	results, err := s.client.CheckAccess(ctx, claims.Principal, p.Requests)
+	results, err := s.client.CheckAccessWithContext(ctx, checks, checkCtx)
 	if err != nil {
 		slog.ErrorContext(ctx, "Access check failed", "error", err, "principal", claims.Principal)
 		switch {
-		case errors.Is(err, constants.ErrPrincipalRequired):
-			return nil, accesssvc.MakeUnauthorized(err)
+		case errors.Is(err, constants.ErrInvalidAccessRequest):
+			return nil, "", accesssvc.MakeBadRequest(err)
+		case errors.Is(err, constants.ErrPrincipalRequired), errors.Is(err, constants.ErrInvalidPrincipal):
+			return nil, "", accesssvc.MakeUnauthorized(err)
 		case errors.Is(err, constants.ErrUnexpectedResponse):
-			return nil, accesssvc.MakeInternalServerError(constants.ErrUnexpectedResponse)
+			return nil, "", accesssvc.MakeInternalServerError(constants.ErrUnexpectedResponse)
 		default:
-			return nil, accesssvc.MakeServiceUnavailable(constants.ErrAccessCheckFailed)
+			return nil, "", serviceUnavailable(ctx, err, constants.ErrAccessCheckFailed)
 		}
 	}
 
-	slog.InfoContext(ctx, "Access check completed", "principal", claims.Principal, "requests_count", len(p.Requests))
-	return &accesssvc.CheckAccessResult{Results: results}, nil
+	slog.InfoContext(ctx, "Access check completed", "principal", claims.Principal, "requests_count", len(p.Requests), "contextual_tuples_count", len(p.ContextualTuples), "version", p.Version)
+	markResults(ctx, results)
+	if p.Version == constants.StructuredResultsAPIVersion {
+		return &accesssvc.CheckAccessResult{Decisions: toAccessCheckDecisions(results)}, constants.StructuredResultsView, nil
+	}
+	return &accesssvc.CheckAccessResult{Results: toResultStrings(results)}, constants.CheckAccessResultView, nil
 }
 
 // MyGrants validates the request and delegates to AccessCheckClient.
@@ -123,37 +452,228 @@ func (s *AccessService) MyGrants(ctx context.Context, p *accesssvc.MyGrantsPaylo
 		return nil, accesssvc.MakeUnauthorized(constants.ErrInvalidAuthContext)
 	}
 
-	if err := requireAPIVersion(p.Version); err != nil {
+	if err := requireAPIVersion(p.Version, constants.SupportedAPIVersion); err != nil {
+		slog.WarnContext(ctx, "Unsupported API version", "version", p.Version)
+		return nil, accesssvc.MakeBadRequest(err)
+	}
+
+	user, err := domain.NewUser(claims.Principal)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid principal for my-grants", "error", err, "principal", claims.Principal)
+		return nil, accesssvc.MakeUnauthorized(err)
+	}
+
+	query := ReadTuplesQuery{
+		User:        user,
+		ObjectTypes: uniqueStrings(p.ObjectType),
+		PageSize:    p.PageSize,
+	}
+	if query.PageSize <= 0 {
+		query.PageSize = constants.DefaultGrantsPageSize
+	}
+	if p.Relation != nil {
+		query.Relation = *p.Relation
+	}
+	if p.ContinuationToken != nil {
+		query.ContinuationToken = *p.ContinuationToken
+	}
+
+	page, err := s.client.ReadTuples(ctx, query)
+	if err != nil {
+		slog.ErrorContext(ctx, "Reading tuples failed", "error", err, "principal", claims.Principal, "subject", constants.ReadTuplesSubject, "object_types", query.ObjectTypes, "relation", query.Relation)
+		if errors.Is(err, constants.ErrUnexpectedResponse) {
+			return nil, accesssvc.MakeInternalServerError(constants.ErrUnexpectedResponse)
+		}
+		return nil, serviceUnavailable(ctx, err, constants.ErrReadingTuplesFailed)
+	}
+
+	grants, grantsByType := groupGrantsByType(page.Tuples, query.ObjectTypes)
+	slog.InfoContext(ctx, "My grants completed", "principal", claims.Principal, "object_types", query.ObjectTypes, "relation", query.Relation, "grants_count", len(grants), "has_more", page.ContinuationToken != "")
+	result := &accesssvc.MyGrantsResult{Grants: grants, GrantsByType: grantsByType}
+	if page.ContinuationToken != "" {
+		result.ContinuationToken = &page.ContinuationToken
+	}
+	return result, nil
+}
+
+// ListObjects validates the request and delegates to AccessCheckClient.
+func (s *AccessService) ListObjects(ctx context.Context, p *accesssvc.ListObjectsPayload) (*accesssvc.ListObjectsResult, error) {
+	claims, ok := claimsFromContext(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "Failed to get claims from context")
+		return nil, accesssvc.MakeUnauthorized(constants.ErrInvalidAuthContext)
+	}
+
+	if err := requireAPIVersion(p.Version, constants.SupportedAPIVersion); err != nil {
 		slog.WarnContext(ctx, "Unsupported API version", "version", p.Version)
 		return nil, accesssvc.MakeBadRequest(err)
 	}
 
-	if claims.Principal == "" {
-		slog.ErrorContext(ctx, "Principal is required for my-grants")
-		return nil, accesssvc.MakeUnauthorized(constants.ErrPrincipalRequired)
+	user, err := domain.NewUser(claims.Principal)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid principal for list-objects", "error", err, "principal", claims.Principal)
+		return nil, accesssvc.MakeUnauthorized(err)
 	}
 
-	grants, err := s.client.ReadTuples(ctx, claims.Principal, p.ObjectType)
+	objects, err := s.client.ListObjects(ctx, user, p.ObjectType, p.Relation)
 	if err != nil {
-		slog.ErrorContext(ctx, "Reading tuples failed", "error", err, "principal", claims.Principal, "subject", constants.ReadTuplesSubject, "object_type", p.ObjectType)
+		slog.ErrorContext(ctx, "Listing objects failed", "error", err, "principal", claims.Principal, "subject", constants.ListObjectsSubject, "object_type", p.ObjectType, "relation", p.Relation)
 		if errors.Is(err, constants.ErrUnexpectedResponse) {
 			return nil, accesssvc.MakeInternalServerError(constants.ErrUnexpectedResponse)
 		}
-		return nil, accesssvc.MakeServiceUnavailable(constants.ErrReadingTuplesFailed)
+		return nil, serviceUnavailable(ctx, err, constants.ErrListingObjectsFailed)
 	}
 
-	slog.InfoContext(ctx, "My grants completed", "principal", claims.Principal, "object_type", p.ObjectType, "grants_count", len(grants))
-	return &accesssvc.MyGrantsResult{Grants: grants}, nil
+	slog.InfoContext(ctx, "List objects completed", "principal", claims.Principal, "object_type", p.ObjectType, "relation", p.Relation, "objects_count", len(objects))
+	return &accesssvc.ListObjectsResult{Objects: objects}, nil
+}
+
+// ListUsers checks that the caller holds the configured guard relation on the
+// object, then delegates to AccessCheckClient.
+func (s *AccessService) ListUsers(ctx context.Context, p *accesssvc.ListUsersPayload) (*accesssvc.ListUsersResult, error) {
+	claims, ok := claimsFromContext(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "Failed to get claims from context")
+		return nil, accesssvc.MakeUnauthorized(constants.ErrInvalidAuthContext)
+	}
+
+	if err := requireAPIVersion(p.Version, constants.SupportedAPIVersion); err != nil {
+		slog.WarnContext(ctx, "Unsupported API version", "version", p.Version)
+		return nil, accesssvc.MakeBadRequest(err)
+	}
+
+	user, err := domain.NewUser(claims.Principal)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid principal for list-users", "error", err, "principal", claims.Principal)
+		return nil, accesssvc.MakeUnauthorized(err)
+	}
+
+	guard := domain.Tuple{Object: p.Object, Relation: s.listUsersRelation, User: user}
+	decisions, err := s.client.CheckAccess(ctx, []domain.Tuple{guard})
+	if err != nil {
+		slog.ErrorContext(ctx, "List users guard check failed", "error", err, "principal", claims.Principal, "object", p.Object)
+		switch {
+		case errors.Is(err, constants.ErrInvalidAccessRequest):
+			return nil, accesssvc.MakeBadRequest(err)
+		case errors.Is(err, constants.ErrUnexpectedResponse):
+			return nil, accesssvc.MakeInternalServerError(constants.ErrUnexpectedResponse)
+		default:
+			return nil, serviceUnavailable(ctx, err, constants.ErrAccessCheckFailed)
+		}
+	}
+	if !decisions[0].Allowed {
+		slog.WarnContext(ctx, "List users denied", "principal", claims.Principal, "object", p.Object, "required_relation", s.listUsersRelation)
+		return nil, accesssvc.MakeForbidden(constants.ErrListUsersForbidden)
+	}
+
+	entries, err := s.client.ListUsers(ctx, p.Object, p.Relation)
+	if err != nil {
+		slog.ErrorContext(ctx, "Listing users failed", "error", err, "principal", claims.Principal, "subject", constants.ListUsersSubject, "object", p.Object, "relation", p.Relation)
+		if errors.Is(err, constants.ErrUnexpectedResponse) {
+			return nil, accesssvc.MakeInternalServerError(constants.ErrUnexpectedResponse)
+		}
+		return nil, serviceUnavailable(ctx, err, constants.ErrListingUsersFailed)
+	}
+
+	users, usersets := splitUsersets(entries)
+	slog.InfoContext(ctx, "List users completed", "principal", claims.Principal, "object", p.Object, "relation", p.Relation, "users_count", len(users), "usersets_count", len(usersets))
+	return &accesssvc.ListUsersResult{Users: users, Usersets: usersets}, nil
+}
+
+// MyPermissions checks every relation the permission model defines for the
+// object's type and returns the ones the caller holds.
+func (s *AccessService) MyPermissions(ctx context.Context, p *accesssvc.MyPermissionsPayload) (*accesssvc.MyPermissionsResult, error) {
+	claims, ok := claimsFromContext(ctx)
+	if !ok {
+		slog.ErrorContext(ctx, "Failed to get claims from context")
+		return nil, accesssvc.MakeUnauthorized(constants.ErrInvalidAuthContext)
+	}
+
+	if err := requireAPIVersion(p.Version, constants.SupportedAPIVersion); err != nil {
+		slog.WarnContext(ctx, "Unsupported API version", "version", p.Version)
+		return nil, accesssvc.MakeBadRequest(err)
+	}
+
+	user, err := domain.NewUser(claims.Principal)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid principal for my-permissions", "error", err, "principal", claims.Principal)
+		return nil, accesssvc.MakeUnauthorized(err)
+	}
+
+	object := domain.Tuple{Object: p.Object, User: user}
+	relations, ok := s.permissionModel.Relations(object.ObjectType())
+	if !ok {
+		slog.WarnContext(ctx, "Object type not in permission model", "object", p.Object)
+		return nil, accesssvc.MakeBadRequest(fmt.Errorf("%w: %s", constants.ErrUnknownObjectType, object.ObjectType()))
+	}
+
+	checks := make([]domain.Tuple, 0, len(relations))
+	for _, relation := range relations {
+		object.Relation = relation
+		checks = append(checks, object)
+	}
+
+	results, err := s.client.CheckAccess(ctx, checks)
+	if err != nil {
+		slog.ErrorContext(ctx, "Permissions check failed", "error", err, "principal", claims.Principal, "object", p.Object)
+		switch {
+		case errors.Is(err, constants.ErrInvalidAccessRequest):
+			return nil, accesssvc.MakeBadRequest(err)
+		case errors.Is(err, constants.ErrUnexpectedResponse):
+			return nil, accesssvc.MakeInternalServerError(constants.ErrUnexpectedResponse)
+		default:
+			return nil, serviceUnavailable(ctx, err, constants.ErrAccessCheckFailed)
+		}
+	}
+
+	markResults(ctx, results)
+	held := make([]string, 0, len(results))
+	for _, result := range results {
+		if result.Allowed {
+			held = append(held, result.Relation)
+		}
+	}
+
+	slog.InfoContext(ctx, "My permissions completed", "principal", claims.Principal, "object", p.Object, "checked_count", len(checks), "relations_count", len(held))
+	return &accesssvc.MyPermissionsResult{Object: p.Object, Relations: held}, nil
+}
+
+// serviceUnavailable maps a failed NATS call to a 503 carrying reason. A call
+// that ran out of time, whether by the request deadline or the NATS timeout,
+// is a 504 instead. When the call was refused without being attempted (e.g.
+// by an open circuit breaker) the 503 also advertises when to retry.
+func serviceUnavailable(ctx context.Context, cause, reason error) error {
+	if errors.Is(cause, context.DeadlineExceeded) || errors.Is(cause, constants.ErrRequestTimeout) {
+		return accesssvc.MakeGatewayTimeout(constants.ErrRequestTimeout)
+	}
+
+	var retryErr contracts.RetryAfterError
+	if errors.As(cause, &retryErr) {
+		seconds := ceilSeconds(retryErr.RetryAfter())
+		middleware.SetResponseHeader(ctx, constants.RetryAfterHeader, strconv.Itoa(max(seconds, 1)))
+	}
+	return accesssvc.MakeServiceUnavailable(reason)
+}
+
+// ceilSeconds rounds d up to whole seconds, as used by Retry-After and the
+// RateLimit-* headers.
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
 }
 
 // Readyz checks that both messaging and auth dependencies are healthy.
 func (s *AccessService) Readyz(ctx context.Context) ([]byte, error) {
-	var healthIssues []string
+	var healthIssues, degraded []string
 
 	if err := s.client.HealthCheck(ctx); err != nil {
-		if errors.Is(err, constants.ErrMessagingRepoNotInit) {
+		switch {
+		case errors.Is(err, constants.ErrMessagingRepoNotInit):
 			healthIssues = append(healthIssues, constants.ErrMsgMessagingRepoNotInit)
-		} else {
+		case errors.Is(err, constants.ErrCircuitOpen):
+			// fga-sync is failing, not this instance: stay in rotation so
+			// degraded mode keeps serving while every replica's circuit is open.
+			degraded = append(degraded, err.Error())
+		default:
 			healthIssues = append(healthIssues, fmt.Sprintf("%s: %v", constants.ErrMsgNATSConnUnhealthy, err))
 		}
 	}
@@ -162,7 +682,14 @@ func (s *AccessService) Readyz(ctx context.Context) ([]byte, error) {
 		healthIssues = append(healthIssues, constants.ErrMsgAuthRepoNotInit)
 	} else {
 		if err := s.authRepo.HealthCheck(ctx); err != nil {
-			healthIssues = append(healthIssues, fmt.Sprintf("auth service unhealthy: %v", err))
+			// Report each unhealthy issuer as its own issue
+			issues := []error{err}
+			if joined, ok := err.(interface{ Unwrap() []error }); ok {
+				issues = joined.Unwrap()
+			}
+			for _, issue := range issues {
+				healthIssues = append(healthIssues, fmt.Sprintf("auth service unhealthy: %v", issue))
+			}
 		}
 	}
 
@@ -171,6 +698,11 @@ func (s *AccessService) Readyz(ctx context.Context) ([]byte, error) {
 		return nil, accesssvc.MakeNotReady(fmt.Errorf("%s: %v", constants.ErrMsgServiceDepsUnhealthy, healthIssues))
 	}
 
+	if len(degraded) > 0 {
+		slog.WarnContext(ctx, "Readiness check passed with degraded dependencies", "degraded", degraded)
+		return fmt.Appendf(nil, "%s (degraded: %s)", constants.HealthOKResponse, strings.Join(degraded, "; ")), nil
+	}
+
 	slog.DebugContext(ctx, "Readiness check passed - all dependencies healthy")
 	return []byte(constants.HealthOKResponse), nil
 }
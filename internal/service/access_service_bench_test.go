@@ -5,37 +5,55 @@ package service
 
 import (
 	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
 )
 
+// benchChecks builds check tuples for user from "type:id#relation" strings.
+func benchChecks(b *testing.B, user string, requests ...string) []domain.Tuple {
+	b.Helper()
+	checks, err := parseCheckRequests(requests, user)
+	if err != nil {
+		b.Fatalf("invalid benchmark requests: %v", err)
+	}
+	return checks
+}
+
 // BenchmarkBuildMessage measures the NATS message construction path.
 func BenchmarkBuildMessage(b *testing.B) {
 	client := NewAccessCheckClient(&mockMessagingRepository{})
-	principal := "test-user-with-long-name"
-	resources := []string{
-		"repository/project1",
-		"repository/project2",
-		"repository/project3",
-		"repository/project4",
-		"repository/project5",
-		"organization/org1",
-		"organization/org2",
-		"team/team1",
-		"team/team2",
-		"project/project1",
-	}
+	checks := benchChecks(b, "user:test-user-with-long-name",
+		"repository:project1#viewer",
+		"repository:project2#viewer",
+		"repository:project3#viewer",
+		"repository:project4#viewer",
+		"repository:project5#viewer",
+		"organization:org1#member",
+		"organization:org2#member",
+		"team:team1#member",
+		"team:team2#member",
+		"project:project1#viewer",
+	)
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_ = client.buildMessage(principal, resources)
+		_ = client.buildMessage(checks)
 	}
 }
 
 // BenchmarkParseResponse measures the NATS response parsing path.
 func BenchmarkParseResponse(b *testing.B) {
 	client := NewAccessCheckClient(&mockMessagingRepository{})
-	responseData := []byte("true\nfalse\ntrue\nfalse\ntrue\nfalse\ntrue\nfalse\ntrue\nfalse")
+	responseData := []byte(strings.Repeat("project:project1#viewer@user:test-user\ttrue\ncommittee:committee1#writer@user:test-user\tfalse\n", 5))
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -45,23 +63,72 @@ func BenchmarkParseResponse(b *testing.B) {
 
 // BenchmarkCheckAccess measures the full CheckAccess path with a mocked NATS response.
 func BenchmarkCheckAccess(b *testing.B) {
+	ctx := context.Background()
+	checks := benchChecks(b, "user:test-user-with-long-name",
+		"project:project1#viewer",
+		"project:project2#viewer",
+		"project:project3#viewer",
+		"committee:committee1#writer",
+		"committee:committee2#writer",
+	)
+	reply := make([]string, 0, len(checks))
+	for i := len(checks) - 1; i >= 0; i-- {
+		reply = append(reply, domain.CheckResult{Tuple: checks[i], Allowed: true}.String())
+	}
 	client := NewAccessCheckClient(&mockMessagingRepository{
 		requestFunc: func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
-			return []byte("true\nfalse\ntrue\nfalse\ntrue"), nil
+			return []byte(strings.Join(reply, "\n")), nil
 		},
 	})
-	ctx := context.Background()
-	principal := "test-user-with-long-name"
-	resources := []string{
-		"repository/project1",
-		"repository/project2",
-		"repository/project3",
-		"repository/project4",
-		"repository/project5",
-	}
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_, _ = client.CheckAccess(ctx, principal, resources)
+		_, _ = client.CheckAccess(ctx, checks)
+	}
+}
+
+// BenchmarkJWTAuth measures JWTAuth for one token reused across requests,
+// with and without the token cache. The auth repository verifies an RSA-PSS
+// signature on every call, as the JWKS validator does.
+func BenchmarkJWTAuth(b *testing.B) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		b.Fatalf("generate RSA key: %v", err)
+	}
+	digest := sha256.Sum256([]byte("header.claims"))
+	signature, err := rsa.SignPSS(rand.Reader, key, crypto.SHA256, digest[:], nil)
+	if err != nil {
+		b.Fatalf("sign: %v", err)
+	}
+	authRepo := &mockAuthRepository{
+		validateTokenFunc: func(_ context.Context, _ string) (*contracts.HeimdallClaims, error) {
+			if err := rsa.VerifyPSS(&key.PublicKey, crypto.SHA256, digest[:], signature, nil); err != nil {
+				return nil, err
+			}
+			return &contracts.HeimdallClaims{Principal: "test-user", ExpiresAt: time.Now().Add(time.Hour)}, nil
+		},
+	}
+
+	benchmarks := []struct {
+		name string
+		opts []Option
+	}{
+		{name: "uncached"},
+		{name: "cached", opts: []Option{WithTokenCache(constants.DefaultTokenCacheSize, constants.DefaultTokenCacheTTL)}},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			service := NewAccessService(authRepo, &mockMessagingRepository{}, bm.opts...)
+			ctx := context.Background()
+			token := constants.BearerTokenPrefix + "header.claims.signature"
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := service.JWTAuth(ctx, token, nil); err != nil {
+					b.Fatalf("JWTAuth failed: %v", err)
+				}
+			}
+		})
 	}
 }
@@ -4,13 +4,18 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
 	accesssvc "github.com/linuxfoundation/lfx-v2-access-check/gen/access_svc"
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
 	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
 	goa "goa.design/goa/v3/pkg"
@@ -21,6 +26,7 @@ import (
 
 type mockAuthRepository struct {
 	validateTokenFunc func(ctx context.Context, token string) (*contracts.HeimdallClaims, error)
+	healthCheckErr    error
 }
 
 func (m *mockAuthRepository) ValidateToken(ctx context.Context, token string) (*contracts.HeimdallClaims, error) {
@@ -31,12 +37,14 @@ func (m *mockAuthRepository) ValidateToken(ctx context.Context, token string) (*
 }
 
 func (m *mockAuthRepository) HealthCheck(_ context.Context) error {
-	return nil
+	return m.healthCheckErr
 }
 
 type mockMessagingRepository struct {
-	requestFunc func(ctx context.Context, subject string, data []byte, timeout time.Duration) ([]byte, error)
-	closeFunc   func() error
+	requestFunc     func(ctx context.Context, subject string, data []byte, timeout time.Duration) ([]byte, error)
+	subscribeFunc   func(subject string, handler contracts.MessageHandler) error
+	closeFunc       func() error
+	healthCheckFunc func(ctx context.Context) error
 }
 
 func (m *mockMessagingRepository) Request(ctx context.Context, subject string, data []byte, timeout time.Duration) ([]byte, error) {
@@ -46,6 +54,13 @@ func (m *mockMessagingRepository) Request(ctx context.Context, subject string, d
 	return []byte("project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#auditor@user:auth0|alice\ttrue"), nil
 }
 
+func (m *mockMessagingRepository) Subscribe(subject string, handler contracts.MessageHandler) error {
+	if m.subscribeFunc != nil {
+		return m.subscribeFunc(subject, handler)
+	}
+	return nil
+}
+
 func (m *mockMessagingRepository) Close() error {
 	if m.closeFunc != nil {
 		return m.closeFunc()
@@ -53,10 +68,22 @@ func (m *mockMessagingRepository) Close() error {
 	return nil
 }
 
-func (m *mockMessagingRepository) HealthCheck(_ context.Context) error {
+func (m *mockMessagingRepository) HealthCheck(ctx context.Context) error {
+	if m.healthCheckFunc != nil {
+		return m.healthCheckFunc(ctx)
+	}
 	return nil
 }
 
+// retryAfterError is a RetryAfterError wrapping constants.ErrCircuitOpen.
+type retryAfterError struct {
+	after time.Duration
+}
+
+func (e retryAfterError) Error() string             { return constants.ErrMsgCircuitOpen }
+func (e retryAfterError) Unwrap() error             { return constants.ErrCircuitOpen }
+func (e retryAfterError) RetryAfter() time.Duration { return e.after }
+
 // contextWithClaims returns a context with HeimdallClaims pre-loaded.
 func contextWithClaims(principal string) context.Context {
 	claims := &contracts.HeimdallClaims{Principal: principal, Email: "test@example.com"}
@@ -126,6 +153,64 @@ func TestJWTAuth_InvalidToken(t *testing.T) {
 	t.Logf("Got expected error: %v", err)
 }
 
+func TestJWTAuth_RateLimit(t *testing.T) {
+	tests := []struct {
+		name           string
+		decision       domain.RateLimitDecision
+		wantErr        string
+		wantRemaining  string
+		wantReset      string
+		wantRetryAfter string
+	}{
+		{
+			name:          "allowed request reports the remaining budget",
+			decision:      domain.RateLimitDecision{Allowed: true, Limit: 20, Remaining: 19, Reset: 100 * time.Millisecond},
+			wantRemaining: "19",
+			wantReset:     "1",
+		},
+		{
+			name:           "request over the limit is refused with 429",
+			decision:       domain.RateLimitDecision{Limit: 20, Reset: 1900 * time.Millisecond, RetryAfter: 100 * time.Millisecond},
+			wantErr:        "TooManyRequests",
+			wantRemaining:  "0",
+			wantReset:      "2",
+			wantRetryAfter: "1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockRateLimitStore{decision: tc.decision}
+			svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{},
+				WithRateLimiter(NewRateLimiter(store, domain.RateLimit{Rate: 10, Burst: 20}, nil, nil)))
+			header := http.Header{}
+			ctx := context.WithValue(context.Background(), constants.ResponseHeaderContextKey, header)
+
+			_, err := svc.JWTAuth(ctx, "Bearer valid-token", &security.JWTScheme{})
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("JWTAuth failed: %v", err)
+				}
+			} else if got := goaErrorName(t, err); got != tc.wantErr {
+				t.Errorf("expected Goa error name %q, got %q", tc.wantErr, got)
+			}
+
+			if got := header.Get(constants.RateLimitLimitHeader); got != "20" {
+				t.Errorf("RateLimit-Limit = %q; want %q", got, "20")
+			}
+			if got := header.Get(constants.RateLimitRemainingHeader); got != tc.wantRemaining {
+				t.Errorf("RateLimit-Remaining = %q; want %q", got, tc.wantRemaining)
+			}
+			if got := header.Get(constants.RateLimitResetHeader); got != tc.wantReset {
+				t.Errorf("RateLimit-Reset = %q; want %q", got, tc.wantReset)
+			}
+			if got := header.Get(constants.RetryAfterHeader); got != tc.wantRetryAfter {
+				t.Errorf("Retry-After = %q; want %q", got, tc.wantRetryAfter)
+			}
+		})
+	}
+}
+
 func TestCheckAccess_Success(t *testing.T) {
 	messagingRepo := &mockMessagingRepository{
 		requestFunc: func(_ context.Context, subject string, _ []byte, _ time.Duration) ([]byte, error) {
@@ -138,15 +223,18 @@ func TestCheckAccess_Success(t *testing.T) {
 	service := NewAccessService(&mockAuthRepository{}, messagingRepo)
 
 	ctx := context.WithValue(context.Background(), constants.ClaimsContextKey,
-		&contracts.HeimdallClaims{Principal: "test-user", Email: "test@example.com"})
+		&contracts.HeimdallClaims{Principal: "auth0|alice", Email: "test@example.com"})
 
-	result, err := service.CheckAccess(ctx, &accesssvc.CheckAccessPayload{
+	result, view, err := service.CheckAccess(ctx, &accesssvc.CheckAccessPayload{
 		Version:  "1",
-		Requests: []string{"resource1", "resource2"},
+		Requests: []string{"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#auditor"},
 	})
 	if err != nil {
 		t.Fatalf("CheckAccess failed: %v", err)
 	}
+	if view != constants.CheckAccessResultView {
+		t.Errorf("expected the v1 view %q, got %q", constants.CheckAccessResultView, view)
+	}
 	if len(result.Results) != 1 {
 		t.Errorf("expected 1 result, got %d", len(result.Results))
 	}
@@ -167,7 +255,7 @@ func TestCheckAccess_Success(t *testing.T) {
 func TestCheckAccess_MissingClaims(t *testing.T) {
 	service := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{})
 
-	_, err := service.CheckAccess(context.Background(), &accesssvc.CheckAccessPayload{
+	_, _, err := service.CheckAccess(context.Background(), &accesssvc.CheckAccessPayload{
 		Version:  "1",
 		Requests: []string{"resource1"},
 	})
@@ -181,8 +269,8 @@ func TestCheckAccess_UnsupportedVersion(t *testing.T) {
 	service := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{})
 	ctx := contextWithClaims("test-user")
 
-	_, err := service.CheckAccess(ctx, &accesssvc.CheckAccessPayload{
-		Version:  "2",
+	_, _, err := service.CheckAccess(ctx, &accesssvc.CheckAccessPayload{
+		Version:  "3",
 		Requests: []string{"resource1"},
 	})
 	if err == nil {
@@ -191,11 +279,67 @@ func TestCheckAccess_UnsupportedVersion(t *testing.T) {
 	t.Logf("Got expected error: %v", err)
 }
 
+func TestCheckAccess_StructuredResults(t *testing.T) {
+	messagingRepo := &mockMessagingRepository{
+		requestFunc: func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+			return []byte("project:abc#auditor@user:auth0|alice\ttrue\ncommittee:xyz#writer@user:auth0|alice\tfalse"), nil
+		},
+	}
+	service := NewAccessService(&mockAuthRepository{}, messagingRepo)
+
+	result, view, err := service.CheckAccess(contextWithClaims("auth0|alice"), &accesssvc.CheckAccessPayload{
+		Version:  "2",
+		Requests: []string{"project:abc#auditor", "committee:xyz#writer"},
+	})
+	if err != nil {
+		t.Fatalf("CheckAccess failed: %v", err)
+	}
+	if view != constants.StructuredResultsView {
+		t.Errorf("expected the v2 view %q, got %q", constants.StructuredResultsView, view)
+	}
+	if result.Results != nil {
+		t.Errorf("expected no v1 results for v2 request, got %v", result.Results)
+	}
+
+	expected := []accesssvc.AccessCheckDecision{
+		{Object: "project:abc", Relation: "auditor", User: "user:auth0|alice", Allowed: true},
+		{Object: "committee:xyz", Relation: "writer", User: "user:auth0|alice", Allowed: false},
+	}
+	if len(result.Decisions) != len(expected) {
+		t.Fatalf("expected %d decisions, got %d", len(expected), len(result.Decisions))
+	}
+	for i, want := range expected {
+		if *result.Decisions[i] != want {
+			t.Errorf("decision[%d]: expected %+v, got %+v", i, want, *result.Decisions[i])
+		}
+	}
+}
+
+func TestCheckAccess_StructuredResultsMalformedLine(t *testing.T) {
+	messagingRepo := &mockMessagingRepository{
+		requestFunc: func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+			return []byte("project:abc#auditor@user:auth0|alice\tmaybe"), nil
+		},
+	}
+	service := NewAccessService(&mockAuthRepository{}, messagingRepo)
+
+	_, _, err := service.CheckAccess(contextWithClaims("auth0|alice"), &accesssvc.CheckAccessPayload{
+		Version:  "2",
+		Requests: []string{"project:abc#auditor"},
+	})
+	if err == nil {
+		t.Fatal("expected error for malformed result line, got nil")
+	}
+	if got := goaErrorName(t, err); got != "InternalServerError" {
+		t.Errorf("expected Goa error name %q, got %q", "InternalServerError", got)
+	}
+}
+
 func TestCheckAccess_EmptyRequests(t *testing.T) {
 	service := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{})
 	ctx := contextWithClaims("test-user")
 
-	result, err := service.CheckAccess(ctx, &accesssvc.CheckAccessPayload{
+	result, _, err := service.CheckAccess(ctx, &accesssvc.CheckAccessPayload{
 		Version:  "1",
 		Requests: []string{},
 	})
@@ -215,9 +359,9 @@ func TestCheckAccess_NATSFailure(t *testing.T) {
 	}
 	service := NewAccessService(&mockAuthRepository{}, messagingRepo)
 
-	_, err := service.CheckAccess(contextWithClaims("test-user"), &accesssvc.CheckAccessPayload{
+	_, _, err := service.CheckAccess(contextWithClaims("test-user"), &accesssvc.CheckAccessPayload{
 		Version:  "1",
-		Requests: []string{"resource1"},
+		Requests: []string{"project:abc#viewer"},
 	})
 	if err == nil {
 		t.Fatal("CheckAccess should fail on NATS error")
@@ -225,6 +369,172 @@ func TestCheckAccess_NATSFailure(t *testing.T) {
 	t.Logf("Got expected error: %v", err)
 }
 
+// contextWithClientClaims returns a context carrying claims for principal
+// issued to clientID.
+func contextWithClientClaims(principal, clientID string) context.Context {
+	claims := &contracts.HeimdallClaims{Principal: principal, ClientID: clientID, Audience: []string{"lfx-v2-access-check"}}
+	return context.WithValue(context.Background(), constants.ClaimsContextKey, claims)
+}
+
+func TestCheckAccess_ContextualTuples(t *testing.T) {
+	svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{
+		requestFunc: func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+			var req contextualCheckRequest
+			if err := json.Unmarshal(data, &req); err != nil {
+				t.Fatalf("expected JSON payload, got %q: %v", data, err)
+			}
+			if !slices.Equal(req.ContextualTuples, []string{"project:abc#viewer@user:auth0|bob"}) {
+				t.Errorf("unexpected contextual tuples: %v", req.ContextualTuples)
+			}
+			if req.Context["ip"] != "10.0.0.1" {
+				t.Errorf("unexpected context: %v", req.Context)
+			}
+			return []byte("project:abc#viewer@user:auth0|alice\ttrue"), nil
+		},
+	}, WithContextualTupleClients([]string{"what-if-ui"}))
+
+	result, _, err := svc.CheckAccess(contextWithClientClaims("auth0|alice", "what-if-ui"), &accesssvc.CheckAccessPayload{
+		Version:  "2",
+		Requests: []string{"project:abc#viewer"},
+		ContextualTuples: []*accesssvc.ContextualTuple{
+			{Object: "project:abc", Relation: "viewer", User: "user:auth0|bob"},
+		},
+		Context: map[string]any{"ip": "10.0.0.1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Decisions) != 1 || !result.Decisions[0].Allowed {
+		t.Fatalf("unexpected decisions: %+v", result.Decisions)
+	}
+	if result.Decisions[0].Contextual == nil || !*result.Decisions[0].Contextual {
+		t.Error("expected the decision to be marked contextual")
+	}
+}
+
+func TestCheckAccess_ContextualTuplesGatedByClient(t *testing.T) {
+	tests := []struct {
+		name     string
+		allowed  []string
+		clientID string
+		want     string
+	}{
+		{name: "no allowed clients", clientID: "what-if-ui", want: "Forbidden"},
+		{name: "other client", allowed: []string{"what-if-ui"}, clientID: "bulk-importer", want: "Forbidden"},
+		{name: "audience is not a client", allowed: []string{"lfx-v2-access-check"}, clientID: "bulk-importer", want: "Forbidden"},
+		{name: "allowed client", allowed: []string{"what-if-ui"}, clientID: "what-if-ui"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{
+				requestFunc: func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+					return []byte("project:abc#viewer@user:auth0|alice\tfalse"), nil
+				},
+			}, WithContextualTupleClients(tc.allowed))
+
+			_, _, err := svc.CheckAccess(contextWithClientClaims("auth0|alice", tc.clientID), &accesssvc.CheckAccessPayload{
+				Version:  "1",
+				Requests: []string{"project:abc#viewer"},
+				ContextualTuples: []*accesssvc.ContextualTuple{
+					{Object: "project:abc", Relation: "viewer", User: "user:auth0|bob"},
+				},
+			})
+			if tc.want == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if got := goaErrorName(t, err); got != tc.want {
+				t.Errorf("expected Goa error name %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCheckAccess_ConditionContextGatedByClient(t *testing.T) {
+	svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{
+		requestFunc: func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+			t.Error("nothing should be sent for a caller not allowed to set condition context")
+			return nil, nil
+		},
+	}, WithContextualTupleClients([]string{"what-if-ui"}))
+
+	_, _, err := svc.CheckAccess(contextWithClientClaims("auth0|alice", "bulk-importer"), &accesssvc.CheckAccessPayload{
+		Version:  "2",
+		Requests: []string{"project:abc#viewer"},
+		Context:  map[string]any{"current_time": "2026-01-01T00:00:00Z", "ip": "10.0.0.1"},
+	})
+	if got := goaErrorName(t, err); got != "Forbidden" {
+		t.Errorf("expected Goa error name %q, got %q", "Forbidden", got)
+	}
+}
+
+func TestCheckAccess_ContextualSelfGrantRejected(t *testing.T) {
+	tests := []struct {
+		name  string
+		tuple *accesssvc.ContextualTuple
+	}{
+		{name: "checked tuple", tuple: &accesssvc.ContextualTuple{Object: "project:abc", Relation: "viewer", User: "user:auth0|alice"}},
+		{name: "caller on another relation", tuple: &accesssvc.ContextualTuple{Object: "project:abc", Relation: "owner", User: "user:auth0|alice"}},
+		{name: "caller joining a group", tuple: &accesssvc.ContextualTuple{Object: "team:core", Relation: "member", User: "user:auth0|alice"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{
+				requestFunc: func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+					t.Error("nothing should be sent for a self-granting contextual tuple")
+					return nil, nil
+				},
+			}, WithContextualTupleClients([]string{"what-if-ui"}))
+
+			_, _, err := svc.CheckAccess(contextWithClientClaims("auth0|alice", "what-if-ui"), &accesssvc.CheckAccessPayload{
+				Version:          "2",
+				Requests:         []string{"project:abc#viewer"},
+				ContextualTuples: []*accesssvc.ContextualTuple{tc.tuple},
+			})
+			if got := goaErrorName(t, err); got != "BadRequest" {
+				t.Errorf("expected Goa error name %q, got %q", "BadRequest", got)
+			}
+		})
+	}
+}
+
+func TestCheckAccess_InvalidContextualTuple(t *testing.T) {
+	svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{}, WithContextualTupleClients([]string{"what-if-ui"}))
+
+	_, _, err := svc.CheckAccess(contextWithClientClaims("auth0|alice", "what-if-ui"), &accesssvc.CheckAccessPayload{
+		Version:  "1",
+		Requests: []string{"project:abc#viewer"},
+		ContextualTuples: []*accesssvc.ContextualTuple{
+			{Object: "project:abc", Relation: "viewer", User: "alice bob"},
+		},
+	})
+	if got := goaErrorName(t, err); got != "BadRequest" {
+		t.Errorf("expected Goa error name %q, got %q", "BadRequest", got)
+	}
+}
+
+func TestCheckAccess_InvalidRequests(t *testing.T) {
+	service := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{})
+
+	_, _, err := service.CheckAccess(contextWithClaims("test-user"), &accesssvc.CheckAccessPayload{
+		Version:  "1",
+		Requests: []string{"project:abc#viewer", "project:abc#viewer@user:bob"},
+	})
+	if err == nil {
+		t.Fatal("CheckAccess should fail for an invalid request entry")
+	}
+	if got := goaErrorName(t, err); got != "BadRequest" {
+		t.Errorf("expected Goa error name %q, got %q", "BadRequest", got)
+	}
+	if !strings.Contains(err.Error(), "requests[1]") {
+		t.Errorf("expected error to name requests[1], got %v", err)
+	}
+}
+
 func TestReadyz_Success(t *testing.T) {
 	service := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{})
 
@@ -247,6 +557,41 @@ func TestReadyz_MessagingRepoNil(t *testing.T) {
 	t.Logf("Got expected error: %v", err)
 }
 
+func TestReadyz_CircuitOpen(t *testing.T) {
+	service := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{
+		healthCheckFunc: func(_ context.Context) error {
+			return retryAfterError{after: 5 * time.Second}
+		},
+	})
+
+	result, err := service.Readyz(context.Background())
+	if err != nil {
+		t.Fatalf("Readyz should pass while the circuit is open, got %v", err)
+	}
+	if !strings.HasPrefix(string(result), constants.HealthOKResponse) || !strings.Contains(string(result), constants.ErrMsgCircuitOpen) {
+		t.Errorf("expected readiness body to report the open circuit, got %q", result)
+	}
+}
+
+func TestReadyz_UnhealthyIssuers(t *testing.T) {
+	service := NewAccessService(&mockAuthRepository{
+		healthCheckErr: errors.Join(
+			errors.New("issuer https://a.example.com: JWKS endpoint not accessible"),
+			errors.New("issuer https://b.example.com: JWKS endpoint not accessible"),
+		),
+	}, &mockMessagingRepository{})
+
+	_, err := service.Readyz(context.Background())
+	if err == nil {
+		t.Fatal("Readyz should fail while issuers are unhealthy")
+	}
+	for _, issuer := range []string{"https://a.example.com", "https://b.example.com"} {
+		if !strings.Contains(err.Error(), "auth service unhealthy: issuer "+issuer) {
+			t.Errorf("expected readiness error to report issuer %s, got %v", issuer, err)
+		}
+	}
+}
+
 func TestLivez(t *testing.T) {
 	service := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{})
 
@@ -274,7 +619,7 @@ func TestMyGrants_Success(t *testing.T) {
 	result, err := svc.MyGrants(contextWithClaims(principal), &accesssvc.MyGrantsPayload{
 		BearerToken: "tok",
 		Version:     "1",
-		ObjectType:  "project",
+		ObjectType:  []string{"project"},
 	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -295,7 +640,7 @@ func TestMyGrants_EmptyResults(t *testing.T) {
 	result, err := svc.MyGrants(contextWithClaims("auth0|user"), &accesssvc.MyGrantsPayload{
 		BearerToken: "tok",
 		Version:     "1",
-		ObjectType:  "committee",
+		ObjectType:  []string{"committee"},
 	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -308,13 +653,121 @@ func TestMyGrants_EmptyResults(t *testing.T) {
 	}
 }
 
+func TestMyGrants_Pagination(t *testing.T) {
+	tests := []struct {
+		name         string
+		payload      *accesssvc.MyGrantsPayload
+		wantRequest  readTuplesRequest
+		reply        string
+		wantNextPage *string
+	}{
+		{
+			name:         "default page size",
+			payload:      &accesssvc.MyGrantsPayload{Version: "1", ObjectType: []string{"project"}},
+			wantRequest:  readTuplesRequest{User: "user:alice", ObjectType: "project", PageSize: constants.DefaultGrantsPageSize},
+			reply:        `{"results":["project:abc#auditor@user:alice"],"continuation_token":"next"}`,
+			wantNextPage: ptr("next"),
+		},
+		{
+			name: "explicit page and token",
+			payload: &accesssvc.MyGrantsPayload{
+				Version:           "1",
+				ObjectType:        []string{"project"},
+				PageSize:          10,
+				ContinuationToken: ptr("next"),
+			},
+			wantRequest: readTuplesRequest{User: "user:alice", ObjectType: "project", PageSize: 10, ContinuationToken: "next"},
+			reply:       `{"results":["project:xyz#writer@user:alice"]}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{
+				requestFunc: func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+					var req readTuplesRequest
+					if err := json.Unmarshal(data, &req); err != nil {
+						t.Fatalf("failed to decode request: %v", err)
+					}
+					if !reflect.DeepEqual(req, tc.wantRequest) {
+						t.Errorf("expected request %+v, got %+v", tc.wantRequest, req)
+					}
+					return []byte(tc.reply), nil
+				},
+			})
+
+			result, err := svc.MyGrants(contextWithClaims("alice"), tc.payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			switch {
+			case tc.wantNextPage == nil && result.ContinuationToken != nil:
+				t.Errorf("expected no continuation token, got %q", *result.ContinuationToken)
+			case tc.wantNextPage != nil && (result.ContinuationToken == nil || *result.ContinuationToken != *tc.wantNextPage):
+				t.Errorf("expected continuation token %q, got %v", *tc.wantNextPage, result.ContinuationToken)
+			}
+		})
+	}
+}
+
+func TestMyGrants_MultipleTypesGrouped(t *testing.T) {
+	svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{
+		requestFunc: func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+			var req readTuplesRequest
+			if err := json.Unmarshal(data, &req); err != nil {
+				t.Fatalf("failed to decode request: %v", err)
+			}
+			want := readTuplesRequest{
+				User:        "user:alice",
+				ObjectTypes: []string{"committee", "project", "meeting"},
+				Relation:    "writer",
+				PageSize:    constants.DefaultGrantsPageSize,
+			}
+			if !reflect.DeepEqual(req, want) {
+				t.Errorf("expected request %+v, got %+v", want, req)
+			}
+			return []byte(`{"results":["project:abc#writer@user:alice","committee:xyz#writer@user:alice","project:def#writer@user:alice"]}`), nil
+		},
+	})
+
+	result, err := svc.MyGrants(contextWithClaims("alice"), &accesssvc.MyGrantsPayload{
+		Version:    "1",
+		ObjectType: []string{"committee", "project", "committee", "meeting"},
+		Relation:   ptr("writer"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantGrants := []string{
+		"committee:xyz#writer@user:alice",
+		"project:abc#writer@user:alice",
+		"project:def#writer@user:alice",
+	}
+	if !slices.Equal(result.Grants, wantGrants) {
+		t.Errorf("expected grants %v, got %v", wantGrants, result.Grants)
+	}
+	wantByType := map[string][]string{
+		"committee": {"committee:xyz#writer@user:alice"},
+		"project":   {"project:abc#writer@user:alice", "project:def#writer@user:alice"},
+		"meeting":   {},
+	}
+	if !reflect.DeepEqual(result.GrantsByType, wantByType) {
+		t.Errorf("expected grants_by_type %v, got %v", wantByType, result.GrantsByType)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
 func TestMyGrants_UnsupportedVersion(t *testing.T) {
 	svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{})
 
 	_, err := svc.MyGrants(contextWithClaims("auth0|user"), &accesssvc.MyGrantsPayload{
 		BearerToken: "tok",
 		Version:     "2",
-		ObjectType:  "project",
+		ObjectType:  []string{"project"},
 	})
 	if err == nil {
 		t.Fatal("expected error for unsupported version, got nil")
@@ -325,15 +778,258 @@ func TestMyGrants_MissingClaims(t *testing.T) {
 	svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{})
 
 	_, err := svc.MyGrants(context.Background(), &accesssvc.MyGrantsPayload{
+		BearerToken: "tok",
+		Version:     "1",
+		ObjectType:  []string{"project"},
+	})
+	if err == nil {
+		t.Fatal("expected unauthorized error, got nil")
+	}
+}
+
+func TestListObjects_Success(t *testing.T) {
+	messagingRepo := &mockMessagingRepository{
+		requestFunc: func(_ context.Context, subject string, _ []byte, _ time.Duration) ([]byte, error) {
+			if subject != constants.ListObjectsSubject {
+				t.Errorf("unexpected NATS subject: %s", subject)
+			}
+			return []byte(`{"results":["project:a27394a3-7a6c-4d0f-9e0f-692d8753924f","project:b3c72e18-1a2b-4c3d-8e9f-123456789abc"]}`), nil
+		},
+	}
+	svc := NewAccessService(&mockAuthRepository{}, messagingRepo)
+
+	result, err := svc.ListObjects(contextWithClaims("auth0|testuser"), &accesssvc.ListObjectsPayload{
+		BearerToken: "tok",
+		Version:     "1",
+		ObjectType:  "project",
+		Relation:    "viewer",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Objects) != 2 {
+		t.Errorf("expected 2 objects, got %d", len(result.Objects))
+	}
+}
+
+func TestListObjects_UnsupportedVersion(t *testing.T) {
+	svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{})
+
+	_, err := svc.ListObjects(contextWithClaims("auth0|user"), &accesssvc.ListObjectsPayload{
+		BearerToken: "tok",
+		Version:     "2",
+		ObjectType:  "project",
+		Relation:    "viewer",
+	})
+	if err == nil {
+		t.Fatal("expected error for unsupported version, got nil")
+	}
+}
+
+func TestListObjects_MissingClaims(t *testing.T) {
+	svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{})
+
+	_, err := svc.ListObjects(context.Background(), &accesssvc.ListObjectsPayload{
 		BearerToken: "tok",
 		Version:     "1",
 		ObjectType:  "project",
+		Relation:    "viewer",
 	})
 	if err == nil {
 		t.Fatal("expected unauthorized error, got nil")
 	}
 }
 
+// listUsersRepo answers the list-users guard check with guardAllowed and the
+// list-users request with listReply, recording the guard payload it received.
+func listUsersRepo(t *testing.T, guardAllowed bool, listReply string, guardPayload *string) *mockMessagingRepository {
+	t.Helper()
+	return &mockMessagingRepository{
+		requestFunc: func(_ context.Context, subject string, data []byte, _ time.Duration) ([]byte, error) {
+			switch subject {
+			case constants.AccessCheckSubject:
+				if guardPayload != nil {
+					*guardPayload = string(data)
+				}
+				return []byte(domain.CheckResult{Tuple: mustParseTuple(t, string(data)), Allowed: guardAllowed}.String()), nil
+			case constants.ListUsersSubject:
+				return []byte(listReply), nil
+			default:
+				t.Errorf("unexpected NATS subject: %s", subject)
+				return nil, errors.New("unexpected subject")
+			}
+		},
+	}
+}
+
+func mustParseTuple(t *testing.T, s string) domain.Tuple {
+	t.Helper()
+	tuple, err := domain.ParseTuple(s)
+	if err != nil {
+		t.Fatalf("ParseTuple(%q) failed: %v", s, err)
+	}
+	return tuple
+}
+
+func TestListUsers_Success(t *testing.T) {
+	var guard string
+	svc := NewAccessService(&mockAuthRepository{}, listUsersRepo(t, true, `{"results":["user:auth0|alice","project:abc#writer"]}`, &guard))
+
+	result, err := svc.ListUsers(contextWithClaims("auth0|bob"), &accesssvc.ListUsersPayload{
+		BearerToken: "tok",
+		Version:     "1",
+		Object:      "committee:xyz",
+		Relation:    "member",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "committee:xyz#" + constants.DefaultListUsersRelation + "@user:auth0|bob"; guard != want {
+		t.Errorf("expected guard check %q, got %q", want, guard)
+	}
+	if !slices.Equal(result.Users, []string{"user:auth0|alice"}) {
+		t.Errorf("unexpected users: %v", result.Users)
+	}
+	if !slices.Equal(result.Usersets, []string{"project:abc#writer"}) {
+		t.Errorf("unexpected usersets: %v", result.Usersets)
+	}
+}
+
+func TestListUsers_ConfiguredGuardRelation(t *testing.T) {
+	var guard string
+	svc := NewAccessService(&mockAuthRepository{}, listUsersRepo(t, true, `{"results":[]}`, &guard),
+		WithListUsersRelation("auditor"),
+	)
+
+	result, err := svc.ListUsers(contextWithClaims("auth0|bob"), &accesssvc.ListUsersPayload{
+		BearerToken: "tok",
+		Version:     "1",
+		Object:      "committee:xyz",
+		Relation:    "writer",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "committee:xyz#auditor@user:auth0|bob"; guard != want {
+		t.Errorf("expected guard check %q, got %q", want, guard)
+	}
+	if result.Users == nil || result.Usersets == nil {
+		t.Error("users and usersets should not be nil")
+	}
+}
+
+func TestListUsers_Forbidden(t *testing.T) {
+	svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{
+		requestFunc: func(_ context.Context, subject string, data []byte, _ time.Duration) ([]byte, error) {
+			if subject == constants.ListUsersSubject {
+				t.Error("list-users must not be requested when the guard check is denied")
+			}
+			return []byte(domain.CheckResult{Tuple: mustParseTuple(t, string(data))}.String()), nil
+		},
+	})
+
+	_, err := svc.ListUsers(contextWithClaims("auth0|bob"), &accesssvc.ListUsersPayload{
+		BearerToken: "tok",
+		Version:     "1",
+		Object:      "committee:xyz",
+		Relation:    "writer",
+	})
+	if got := goaErrorName(t, err); got != "Forbidden" {
+		t.Errorf("expected Goa error name %q, got %q", "Forbidden", got)
+	}
+}
+
+func TestListUsers_MissingClaims(t *testing.T) {
+	svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{})
+
+	_, err := svc.ListUsers(context.Background(), &accesssvc.ListUsersPayload{
+		BearerToken: "tok",
+		Version:     "1",
+		Object:      "committee:xyz",
+		Relation:    "writer",
+	})
+	if err == nil {
+		t.Fatal("expected unauthorized error, got nil")
+	}
+}
+
+func TestMyPermissions_Success(t *testing.T) {
+	var sent string
+	svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{
+		requestFunc: func(_ context.Context, subject string, data []byte, _ time.Duration) ([]byte, error) {
+			if subject != constants.AccessCheckSubject {
+				t.Errorf("unexpected NATS subject: %s", subject)
+			}
+			sent = string(data)
+			// Grant every relation except auditor.
+			var lines []string
+			for _, line := range strings.Split(sent, "\n") {
+				tuple := mustParseTuple(t, line)
+				lines = append(lines, domain.CheckResult{Tuple: tuple, Allowed: tuple.Relation != "auditor"}.String())
+			}
+			return []byte(strings.Join(lines, "\n")), nil
+		},
+	}, WithPermissionModel(domain.PermissionModel{"project": {"writer", "auditor", "viewer"}}))
+
+	result, err := svc.MyPermissions(contextWithClaims("alice"), &accesssvc.MyPermissionsPayload{
+		Version: "1",
+		Object:  "project:abc",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantSent := "project:abc#writer@user:alice\nproject:abc#auditor@user:alice\nproject:abc#viewer@user:alice"
+	if sent != wantSent {
+		t.Errorf("expected checks %q, got %q", wantSent, sent)
+	}
+	if result.Object != "project:abc" {
+		t.Errorf("expected object %q, got %q", "project:abc", result.Object)
+	}
+	if !slices.Equal(result.Relations, []string{"writer", "viewer"}) {
+		t.Errorf("expected relations [writer viewer], got %v", result.Relations)
+	}
+}
+
+func TestMyPermissions_NoRelationsHeld(t *testing.T) {
+	svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{
+		requestFunc: func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+			var lines []string
+			for _, line := range strings.Split(string(data), "\n") {
+				lines = append(lines, domain.CheckResult{Tuple: mustParseTuple(t, line)}.String())
+			}
+			return []byte(strings.Join(lines, "\n")), nil
+		},
+	})
+
+	result, err := svc.MyPermissions(contextWithClaims("alice"), &accesssvc.MyPermissionsPayload{
+		Version: "1",
+		Object:  "committee:xyz",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Relations == nil || len(result.Relations) != 0 {
+		t.Errorf("expected empty relations, got %#v", result.Relations)
+	}
+}
+
+func TestMyPermissions_UnknownObjectType(t *testing.T) {
+	svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{
+		requestFunc: func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+			t.Error("no access check expected for an unknown object type")
+			return nil, nil
+		},
+	})
+
+	_, err := svc.MyPermissions(contextWithClaims("alice"), &accesssvc.MyPermissionsPayload{
+		Version: "1",
+		Object:  "widget:abc",
+	})
+	if got := goaErrorName(t, err); got != "BadRequest" {
+		t.Errorf("expected Goa error name %q, got %q", "BadRequest", got)
+	}
+}
+
 // ===== Goa error-mapping tests =====
 // These tests assert the *goa.ServiceError.Name field so that regressions in
 // the InternalServerError / ServiceUnavailable mapping cannot pass silently.
@@ -351,9 +1047,9 @@ func TestCheckAccess_ErrorMapping(t *testing.T) {
 	natsErr := errors.New("NATS connection failed")
 
 	tests := []struct {
-		name         string
-		clientErr    error
-		wantGoaName  string
+		name        string
+		clientErr   error
+		wantGoaName string
 	}{
 		{
 			name:        "ErrUnexpectedResponse → 500 InternalServerError",
@@ -370,6 +1066,16 @@ func TestCheckAccess_ErrorMapping(t *testing.T) {
 			clientErr:   constants.ErrPrincipalRequired,
 			wantGoaName: "Unauthorized",
 		},
+		{
+			name:        "request deadline exceeded → 504 GatewayTimeout",
+			clientErr:   context.DeadlineExceeded,
+			wantGoaName: "GatewayTimeout",
+		},
+		{
+			name:        "NATS timeout → 504 GatewayTimeout",
+			clientErr:   fmt.Errorf("NATS request failed: %w", constants.ErrRequestTimeout),
+			wantGoaName: "GatewayTimeout",
+		},
 	}
 
 	for _, tc := range tests {
@@ -379,7 +1085,7 @@ func TestCheckAccess_ErrorMapping(t *testing.T) {
 					return nil, tc.clientErr
 				},
 			})
-			_, err := svc.CheckAccess(contextWithClaims("alice"), &accesssvc.CheckAccessPayload{
+			_, _, err := svc.CheckAccess(contextWithClaims("alice"), &accesssvc.CheckAccessPayload{
 				Version:  "1",
 				Requests: []string{"project:abc#viewer"},
 			})
@@ -393,6 +1099,79 @@ func TestCheckAccess_ErrorMapping(t *testing.T) {
 	}
 }
 
+func TestCheckAccess_RetryAfterHeader(t *testing.T) {
+	tests := []struct {
+		name      string
+		clientErr error
+		want      string
+	}{
+		{"refused request rounds up to whole seconds", retryAfterError{after: 2500 * time.Millisecond}, "3"},
+		{"refused request retries after at least a second", retryAfterError{after: 10 * time.Millisecond}, "1"},
+		{"failed request sets no header", errors.New("NATS connection failed"), ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{
+				requestFunc: func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+					return nil, tc.clientErr
+				},
+			})
+			header := http.Header{}
+			ctx := context.WithValue(contextWithClaims("alice"), constants.ResponseHeaderContextKey, header)
+
+			_, _, err := svc.CheckAccess(ctx, &accesssvc.CheckAccessPayload{
+				Version:  "1",
+				Requests: []string{"project:abc#viewer"},
+			})
+			if got := goaErrorName(t, err); got != "ServiceUnavailable" {
+				t.Errorf("expected Goa error name %q, got %q", "ServiceUnavailable", got)
+			}
+			if got := header.Get(constants.RetryAfterHeader); got != tc.want {
+				t.Errorf("Retry-After = %q; want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCheckAccess_DegradedModeMarksStale(t *testing.T) {
+	var failing bool
+	svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{
+		requestFunc: func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+			if failing {
+				return nil, errors.New("nats: no responders available for request")
+			}
+			return projectGrantReply(data), nil
+		},
+	},
+		WithDecisionCache(100, time.Nanosecond),
+		WithDegradedMode(time.Minute, false),
+	)
+	payload := &accesssvc.CheckAccessPayload{Version: "2", Requests: []string{"project:abc#viewer"}}
+
+	header := http.Header{}
+	ctx := context.WithValue(contextWithClaims("alice"), constants.ResponseHeaderContextKey, header)
+	result, _, err := svc.CheckAccess(ctx, payload)
+	if err != nil {
+		t.Fatalf("CheckAccess failed: %v", err)
+	}
+	if result.Decisions[0].Stale != nil || header.Get(constants.StaleResponseHeader) != "" {
+		t.Fatal("expected a fresh decision not to be marked stale")
+	}
+
+	failing = true
+	result, _, err = svc.CheckAccess(ctx, payload)
+	if err != nil {
+		t.Fatalf("CheckAccess in degraded mode failed: %v", err)
+	}
+	if d := result.Decisions[0]; !d.Allowed || d.Stale == nil || !*d.Stale {
+		t.Errorf("expected a stale allowed decision, got %+v", d)
+	}
+	if got := header.Get(constants.StaleResponseHeader); got != "true" {
+		t.Errorf("%s = %q; want \"true\"", constants.StaleResponseHeader, got)
+	}
+}
+
 func TestMyGrants_ErrorMapping(t *testing.T) {
 	natsErr := errors.New("NATS connection failed")
 
@@ -421,9 +1200,99 @@ func TestMyGrants_ErrorMapping(t *testing.T) {
 				},
 			})
 			_, err := svc.MyGrants(contextWithClaims("alice"), &accesssvc.MyGrantsPayload{
+				BearerToken: "tok",
+				Version:     "1",
+				ObjectType:  []string{"project"},
+			})
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if got := goaErrorName(t, err); got != tc.wantGoaName {
+				t.Errorf("expected Goa error name %q, got %q", tc.wantGoaName, got)
+			}
+		})
+	}
+}
+
+func TestListObjects_ErrorMapping(t *testing.T) {
+	tests := []struct {
+		name        string
+		clientErr   error
+		wantGoaName string
+	}{
+		{
+			name:        "ErrUnexpectedResponse → 500 InternalServerError",
+			clientErr:   fmt.Errorf("wrap: %w", constants.ErrUnexpectedResponse),
+			wantGoaName: "InternalServerError",
+		},
+		{
+			name:        "NATS transport error → 503 ServiceUnavailable",
+			clientErr:   errors.New("NATS connection failed"),
+			wantGoaName: "ServiceUnavailable",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{
+				requestFunc: func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+					return nil, tc.clientErr
+				},
+			})
+			_, err := svc.ListObjects(contextWithClaims("alice"), &accesssvc.ListObjectsPayload{
 				BearerToken: "tok",
 				Version:     "1",
 				ObjectType:  "project",
+				Relation:    "viewer",
+			})
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if got := goaErrorName(t, err); got != tc.wantGoaName {
+				t.Errorf("expected Goa error name %q, got %q", tc.wantGoaName, got)
+			}
+		})
+	}
+}
+
+func TestListUsers_ErrorMapping(t *testing.T) {
+	tests := []struct {
+		name        string
+		guardErr    error
+		listReply   string
+		wantGoaName string
+	}{
+		{
+			name:        "guard NATS error → 503 ServiceUnavailable",
+			guardErr:    errors.New("NATS connection failed"),
+			wantGoaName: "ServiceUnavailable",
+		},
+		{
+			name:        "list-users backend error → 503 ServiceUnavailable",
+			listReply:   `{"error":"store not found"}`,
+			wantGoaName: "ServiceUnavailable",
+		},
+		{
+			name:        "malformed list-users reply → 500 InternalServerError",
+			listReply:   `{"results":["alice"]}`,
+			wantGoaName: "InternalServerError",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := listUsersRepo(t, true, tc.listReply, nil)
+			if tc.guardErr != nil {
+				repo.requestFunc = func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+					return nil, tc.guardErr
+				}
+			}
+			svc := NewAccessService(&mockAuthRepository{}, repo)
+			_, err := svc.ListUsers(contextWithClaims("alice"), &accesssvc.ListUsersPayload{
+				BearerToken: "tok",
+				Version:     "1",
+				Object:      "committee:xyz",
+				Relation:    "writer",
 			})
 			if err == nil {
 				t.Fatal("expected error, got nil")
@@ -435,3 +1304,39 @@ func TestMyGrants_ErrorMapping(t *testing.T) {
 	}
 }
 
+func TestMyPermissions_ErrorMapping(t *testing.T) {
+	tests := []struct {
+		name        string
+		reply       []byte
+		replyErr    error
+		wantGoaName string
+	}{
+		{
+			name:        "NATS transport error → 503 ServiceUnavailable",
+			replyErr:    errors.New("NATS connection failed"),
+			wantGoaName: "ServiceUnavailable",
+		},
+		{
+			name:        "mismatched reply → 500 InternalServerError",
+			reply:       []byte("project:other#writer@user:alice\ttrue"),
+			wantGoaName: "InternalServerError",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := NewAccessService(&mockAuthRepository{}, &mockMessagingRepository{
+				requestFunc: func(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+					return tc.reply, tc.replyErr
+				},
+			})
+			_, err := svc.MyPermissions(contextWithClaims("alice"), &accesssvc.MyPermissionsPayload{
+				Version: "1",
+				Object:  "project:abc",
+			})
+			if got := goaErrorName(t, err); got != tc.wantGoaName {
+				t.Errorf("expected Goa error name %q, got %q", tc.wantGoaName, got)
+			}
+		})
+	}
+}
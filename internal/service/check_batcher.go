@@ -0,0 +1,192 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// checkBatchSize records the number of tuples in each batched access-check
+// message.
+var checkBatchSize, _ = meter.Int64Histogram("access_check.check_batch.size",
+	metric.WithDescription("Tuples per batched access-check message"),
+	metric.WithUnit("{tuple}"),
+	metric.WithExplicitBucketBoundaries(1, 2, 5, 10, 20, 50, 100, 200, 500),
+)
+
+// sendChecksFunc sends tuples in one access-check message and returns one
+// result per tuple, in order.
+type sendChecksFunc func(ctx context.Context, checks []domain.Tuple) ([]domain.CheckResult, error)
+
+// checkBatcher merges the checks of concurrent callers into shared
+// access-check messages. A batch is sent once window has passed since its
+// first check arrived, or as soon as it holds maxSize tuples, whichever comes
+// first.
+//
+// A batch is sent with the latest deadline among its callers, capped at
+// maxTimeout, so it neither outlives every caller nor is cut short by one.
+type checkBatcher struct {
+	send       sendChecksFunc
+	window     time.Duration
+	maxSize    int
+	maxTimeout time.Duration
+
+	mu      sync.Mutex
+	pending *checkBatch
+}
+
+// checkBatch is one access-check message being assembled. Its fields other
+// than done, results and err are guarded by checkBatcher.mu.
+type checkBatch struct {
+	// ctx is the first caller's context with cancellation and deadline
+	// removed, so the batch keeps its trace without being cut short by that
+	// caller.
+	ctx context.Context
+	// deadline is the latest deadline among the batch's callers.
+	deadline time.Time
+	tuples   []domain.Tuple
+	seen     map[domain.Tuple]struct{}
+	timer    *time.Timer
+
+	done    chan struct{}
+	results map[domain.Tuple]bool
+	err     error
+}
+
+// newCheckBatcher returns a batcher that sends through send. Callers without a
+// deadline, and the batch as a whole, are given at most maxTimeout.
+func newCheckBatcher(send sendChecksFunc, window time.Duration, maxSize int, maxTimeout time.Duration) *checkBatcher {
+	return &checkBatcher{send: send, window: window, maxSize: maxSize, maxTimeout: maxTimeout}
+}
+
+// check answers unique tuples through a shared batch and returns each tuple's
+// decision. Tuples already pending from another caller are sent only once.
+//
+// A caller whose ctx ends stops waiting with ctx.Err(); the batch is still
+// sent for the other callers. Requests with maxSize or more tuples are
+// already a full batch and are sent on their own.
+func (b *checkBatcher) check(ctx context.Context, tuples []domain.Tuple) (map[domain.Tuple]bool, error) {
+	if len(tuples) >= b.maxSize {
+		results, err := b.send(ctx, tuples)
+		if err != nil {
+			return nil, err
+		}
+		checkBatchSize.Record(ctx, int64(len(tuples)))
+		return decisionsByTuple(results), nil
+	}
+
+	batch := b.enqueue(ctx, tuples)
+
+	select {
+	case <-batch.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if batch.err != nil {
+		return nil, batch.err
+	}
+	decided := make(map[domain.Tuple]bool, len(tuples))
+	for _, t := range tuples {
+		decided[t] = batch.results[t]
+	}
+	return decided, nil
+}
+
+// enqueue adds tuples to the pending batch, first flushing it when the tuples
+// would not fit, and returns the batch that will answer them.
+func (b *checkBatcher) enqueue(ctx context.Context, tuples []domain.Tuple) *checkBatch {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.pending != nil && len(b.pending.tuples)+b.pending.newTuples(tuples) > b.maxSize {
+		b.flushLocked(b.pending)
+	}
+	if b.pending == nil {
+		batch := &checkBatch{
+			ctx:  context.WithoutCancel(ctx),
+			seen: make(map[domain.Tuple]struct{}, b.maxSize),
+			done: make(chan struct{}),
+		}
+		batch.timer = time.AfterFunc(b.window, func() { b.flush(batch) })
+		b.pending = batch
+	}
+
+	batch := b.pending
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(b.maxTimeout)
+	}
+	if deadline.After(batch.deadline) {
+		batch.deadline = deadline
+	}
+	for _, t := range tuples {
+		if _, dup := batch.seen[t]; !dup {
+			batch.seen[t] = struct{}{}
+			batch.tuples = append(batch.tuples, t)
+		}
+	}
+	if len(batch.tuples) >= b.maxSize {
+		b.flushLocked(batch)
+	}
+	return batch
+}
+
+// flush sends batch if it is still pending.
+func (b *checkBatcher) flush(batch *checkBatch) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.flushLocked(batch)
+}
+
+// flushLocked detaches batch from the batcher and sends it in the background.
+// It is a no-op for a batch that was already flushed. b.mu must be held.
+func (b *checkBatcher) flushLocked(batch *checkBatch) {
+	if b.pending != batch {
+		return
+	}
+	b.pending = nil
+	batch.timer.Stop()
+
+	deadline := batch.deadline
+	if capped := time.Now().Add(b.maxTimeout); deadline.After(capped) {
+		deadline = capped
+	}
+
+	go func() {
+		ctx, cancel := context.WithDeadline(batch.ctx, deadline)
+		defer cancel()
+		results, err := b.send(ctx, batch.tuples)
+		if err == nil {
+			batch.results = decisionsByTuple(results)
+			checkBatchSize.Record(batch.ctx, int64(len(batch.tuples)))
+		}
+		batch.err = err
+		close(batch.done)
+	}()
+}
+
+// newTuples counts the tuples not yet in the batch.
+func (batch *checkBatch) newTuples(tuples []domain.Tuple) int {
+	n := 0
+	for _, t := range tuples {
+		if _, dup := batch.seen[t]; !dup {
+			n++
+		}
+	}
+	return n
+}
+
+// decisionsByTuple indexes check results by tuple.
+func decisionsByTuple(results []domain.CheckResult) map[domain.Tuple]bool {
+	decided := make(map[domain.Tuple]bool, len(results))
+	for _, result := range results {
+		decided[result.Tuple] = result.Allowed
+	}
+	return decided
+}
@@ -0,0 +1,279 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
+)
+
+// newBatchingTestClient returns a batching client whose responder grants
+// project relations and records every payload it receives.
+func newBatchingTestClient(window time.Duration, maxSize int, replyErr error) (*AccessCheckClient, func() []string) {
+	var (
+		mu   sync.Mutex
+		sent []string
+	)
+	client := newTestClient(func(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
+		mu.Lock()
+		sent = append(sent, string(data))
+		mu.Unlock()
+		if replyErr != nil {
+			return nil, replyErr
+		}
+		return projectGrantReply(data), nil
+	})
+	client.EnableBatching(window, maxSize, time.Second)
+	return client, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(sent)
+	}
+}
+
+// checkConcurrently runs one CheckAccess per request set at the same time and
+// returns each call's results as strings, in call order.
+func checkConcurrently(t *testing.T, client *AccessCheckClient, requests ...[]string) ([][]string, []error) {
+	t.Helper()
+	results := make([][]string, len(requests))
+	errs := make([]error, len(requests))
+	var wg sync.WaitGroup
+	for i, reqs := range requests {
+		checks := aliceChecks(t, reqs...)
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res, err := client.CheckAccess(context.Background(), checks)
+			errs[i] = err
+			for _, r := range res {
+				results[i] = append(results[i], r.String())
+			}
+		}(i)
+	}
+	wg.Wait()
+	return results, errs
+}
+
+func TestCheckBatcher_MergesConcurrentCallers(t *testing.T) {
+	client, sent := newBatchingTestClient(100*time.Millisecond, 100, nil)
+
+	results, errs := checkConcurrently(t, client,
+		[]string{"project:abc#viewer"},
+		[]string{"committee:xyz#writer", "project:abc#viewer"},
+		[]string{"project:def#writer"},
+	)
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("caller %d: unexpected error: %v", i, err)
+		}
+	}
+	if got := sent(); len(got) != 1 || strings.Count(got[0], "\n") != 2 {
+		t.Fatalf("expected one message with 3 unique tuples, got %q", got)
+	}
+
+	want := [][]string{
+		{"project:abc#viewer@user:alice\ttrue"},
+		{"committee:xyz#writer@user:alice\tfalse", "project:abc#viewer@user:alice\ttrue"},
+		{"project:def#writer@user:alice\ttrue"},
+	}
+	for i := range want {
+		if !slices.Equal(results[i], want[i]) {
+			t.Errorf("caller %d: expected %q, got %q", i, want[i], results[i])
+		}
+	}
+}
+
+func TestCheckBatcher_MaxSize(t *testing.T) {
+	tests := []struct {
+		name      string
+		maxSize   int
+		requests  [][]string
+		wantSent  int
+		wantSizes []int
+	}{
+		{
+			name:      "full batch is sent before the window ends",
+			maxSize:   2,
+			requests:  [][]string{{"project:abc#viewer"}, {"project:def#viewer"}},
+			wantSent:  1,
+			wantSizes: []int{2},
+		},
+		{
+			name:      "request at max size is sent on its own",
+			maxSize:   2,
+			requests:  [][]string{{"project:abc#viewer", "project:def#viewer"}},
+			wantSent:  1,
+			wantSizes: []int{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A window far beyond the test timeout proves the size limit
+			// triggered the send.
+			client, sent := newBatchingTestClient(time.Hour, tt.maxSize, nil)
+
+			_, errs := checkConcurrently(t, client, tt.requests...)
+			for i, err := range errs {
+				if err != nil {
+					t.Fatalf("caller %d: unexpected error: %v", i, err)
+				}
+			}
+
+			got := sent()
+			if len(got) != tt.wantSent {
+				t.Fatalf("expected %d messages, got %q", tt.wantSent, got)
+			}
+			for i, size := range tt.wantSizes {
+				if n := strings.Count(got[i], "\n") + 1; n != size {
+					t.Errorf("message %d: expected %d tuples, got %d", i, size, n)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckBatcher_FlushesWhenTuplesDoNotFit(t *testing.T) {
+	var sent []int
+	var mu sync.Mutex
+	b := newCheckBatcher(func(_ context.Context, checks []domain.Tuple) ([]domain.CheckResult, error) {
+		mu.Lock()
+		sent = append(sent, len(checks))
+		mu.Unlock()
+		results := make([]domain.CheckResult, 0, len(checks))
+		for _, c := range checks {
+			results = append(results, domain.CheckResult{Tuple: c})
+		}
+		return results, nil
+	}, 20*time.Millisecond, 3, time.Second)
+
+	first := b.enqueue(context.Background(), aliceChecks(t, "project:a#viewer", "project:b#viewer"))
+	second := b.enqueue(context.Background(), aliceChecks(t, "project:c#viewer", "project:d#viewer"))
+	<-first.done
+	<-second.done
+
+	if first == second {
+		t.Fatal("expected tuples that do not fit to start a new batch")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if !slices.Equal(sent, []int{2, 2}) {
+		t.Errorf("expected two messages of 2 tuples, got %v", sent)
+	}
+}
+
+func TestCheckBatcher_DeadlineFollowsCallers(t *testing.T) {
+	// newDeadlineBatcher returns a batcher that records the deadline each
+	// message is sent with.
+	newDeadlineBatcher := func(maxTimeout time.Duration) (*checkBatcher, chan time.Time) {
+		deadlines := make(chan time.Time, 1)
+		b := newCheckBatcher(func(ctx context.Context, checks []domain.Tuple) ([]domain.CheckResult, error) {
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				t.Error("expected the batch to carry a deadline")
+			}
+			deadlines <- deadline
+			results := make([]domain.CheckResult, 0, len(checks))
+			for _, c := range checks {
+				results = append(results, domain.CheckResult{Tuple: c})
+			}
+			return results, nil
+		}, 10*time.Millisecond, 100, maxTimeout)
+		return b, deadlines
+	}
+
+	t.Run("latest caller deadline", func(t *testing.T) {
+		b, deadlines := newDeadlineBatcher(time.Minute)
+		shortCtx, cancelShort := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancelShort()
+		longCtx, cancelLong := context.WithTimeout(context.Background(), 400*time.Millisecond)
+		defer cancelLong()
+
+		b.enqueue(shortCtx, aliceChecks(t, "project:a#viewer"))
+		batch := b.enqueue(longCtx, aliceChecks(t, "project:b#viewer"))
+		<-batch.done
+
+		want, _ := longCtx.Deadline()
+		if got := <-deadlines; !got.Equal(want) {
+			t.Errorf("batch deadline = %v; want the latest caller deadline %v", got, want)
+		}
+	})
+
+	t.Run("capped at max timeout", func(t *testing.T) {
+		b, deadlines := newDeadlineBatcher(100 * time.Millisecond)
+		start := time.Now()
+		batch := b.enqueue(context.Background(), aliceChecks(t, "project:a#viewer"))
+		<-batch.done
+
+		if got := <-deadlines; got.Sub(start) > 200*time.Millisecond {
+			t.Errorf("batch deadline %v after enqueue; want at most the 100ms max timeout plus the window", got.Sub(start))
+		}
+	})
+}
+
+func TestCheckBatcher_CancelledCallerDoesNotAffectOthers(t *testing.T) {
+	client, sent := newBatchingTestClient(50*time.Millisecond, 100, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelledErr := make(chan error, 1)
+	go func() {
+		_, err := client.CheckAccess(ctx, aliceChecks(t, "project:abc#viewer"))
+		cancelledErr <- err
+	}()
+	time.Sleep(5 * time.Millisecond)
+	cancel()
+
+	results, err := client.CheckAccess(context.Background(), aliceChecks(t, "project:def#viewer"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 || !results[0].Allowed {
+		t.Errorf("expected one allowed result, got %v", results)
+	}
+	if err := <-cancelledErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected cancelled caller to get context.Canceled, got %v", err)
+	}
+	if got := sent(); len(got) != 1 {
+		t.Errorf("expected the batch to be sent once, got %q", got)
+	}
+}
+
+func TestCheckBatcher_ErrorReachesEveryCaller(t *testing.T) {
+	upstreamErr := errors.New("nats: timeout")
+	client, sent := newBatchingTestClient(50*time.Millisecond, 100, upstreamErr)
+
+	_, errs := checkConcurrently(t, client, []string{"project:abc#viewer"}, []string{"project:def#viewer"})
+	for i, err := range errs {
+		if !errors.Is(err, upstreamErr) {
+			t.Errorf("caller %d: expected upstream error, got %v", i, err)
+		}
+	}
+	if got := sent(); len(got) != 1 {
+		t.Errorf("expected one message, got %q", got)
+	}
+}
+
+func TestCheckBatcher_ContextualChecksNotBatched(t *testing.T) {
+	client, sent := newBatchingTestClient(time.Hour, 100, nil)
+
+	_, err := client.CheckAccessWithContext(context.Background(), aliceChecks(t, "project:abc#viewer"), domain.CheckContext{
+		Context: map[string]any{"ip": "10.0.0.1"},
+	})
+	// The responder only understands plaintext; what matters is that the call
+	// was sent immediately instead of waiting an hour for the batch window.
+	if err == nil {
+		t.Fatal("expected the plaintext responder to reject the JSON payload")
+	}
+	if got := sent(); len(got) != 1 || !strings.HasPrefix(got[0], "{") {
+		t.Errorf("expected one JSON message, got %q", got)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var rateLimitDecisions, _ = meter.Int64Counter("access_check.rate_limit.decisions",
+	metric.WithDescription("Rate-limit decisions for authenticated requests, by outcome"),
+	metric.WithUnit("{request}"),
+)
+
+var (
+	rateLimitAllowed    = metric.WithAttributes(attribute.String("outcome", "allowed"))
+	rateLimitLimited    = metric.WithAttributes(attribute.String("outcome", "limited"))
+	rateLimitStoreError = metric.WithAttributes(attribute.String("outcome", "store_error"))
+)
+
+// RateLimiter limits the requests of each caller with a token bucket. Callers
+// are told apart by the token claims named in keyBy; the limit is chosen by
+// principal, falling back to the default limit.
+type RateLimiter struct {
+	store     contracts.RateLimitStore
+	limit     domain.RateLimit
+	overrides map[string]domain.RateLimit
+	keyBy     []string
+}
+
+// NewRateLimiter creates a RateLimiter keeping its state in store. keyBy
+// lists the claims (constants.RateLimitKeyPrincipal, RateLimitKeyClientID,
+// RateLimitKeyAudience) that make up a caller's key; it defaults to the
+// principal.
+func NewRateLimiter(store contracts.RateLimitStore, limit domain.RateLimit, overrides map[string]domain.RateLimit, keyBy []string) *RateLimiter {
+	if len(keyBy) == 0 {
+		keyBy = []string{constants.RateLimitKeyPrincipal}
+	}
+	return &RateLimiter{
+		store:     store,
+		limit:     limit,
+		overrides: overrides,
+		keyBy:     keyBy,
+	}
+}
+
+// Take spends one request of the caller's budget. It reports false when the
+// caller is not limited: its limit is unlimited, or the store failed, in
+// which case the request is let through.
+func (l *RateLimiter) Take(ctx context.Context, claims *contracts.HeimdallClaims) (domain.RateLimitDecision, bool) {
+	limit := l.limit
+	if override, ok := l.overrides[claims.Principal]; ok {
+		limit = override
+	}
+	if limit.Unlimited() {
+		return domain.RateLimitDecision{}, false
+	}
+
+	decision, err := l.store.Take(ctx, l.key(claims), limit)
+	if err != nil {
+		slog.WarnContext(ctx, "Rate limit store failed; allowing request", "error", err, "principal", claims.Principal)
+		rateLimitDecisions.Add(ctx, 1, rateLimitStoreError)
+		return domain.RateLimitDecision{}, false
+	}
+
+	if decision.Allowed {
+		rateLimitDecisions.Add(ctx, 1, rateLimitAllowed)
+	} else {
+		rateLimitDecisions.Add(ctx, 1, rateLimitLimited)
+	}
+	return decision, true
+}
+
+// key builds the caller's rate-limit key from the configured claims. Values
+// are quoted so that no claim value can spill into another.
+func (l *RateLimiter) key(claims *contracts.HeimdallClaims) string {
+	var b strings.Builder
+	for i, claim := range l.keyBy {
+		var value string
+		switch claim {
+		case constants.RateLimitKeyPrincipal:
+			value = claims.Principal
+		case constants.RateLimitKeyClientID:
+			value = claims.ClientID
+		case constants.RateLimitKeyAudience:
+			value = strings.Join(claims.Audience, " ")
+		}
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(claim)
+		b.WriteByte('=')
+		b.WriteString(strconv.Quote(value))
+	}
+	return b.String()
+}
@@ -0,0 +1,117 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+// mockRateLimitStore records the keys and limits it is asked for and returns
+// decision, or err when set.
+type mockRateLimitStore struct {
+	decision domain.RateLimitDecision
+	err      error
+
+	keys   []string
+	limits []domain.RateLimit
+}
+
+func (m *mockRateLimitStore) Take(_ context.Context, key string, limit domain.RateLimit) (domain.RateLimitDecision, error) {
+	m.keys = append(m.keys, key)
+	m.limits = append(m.limits, limit)
+	return m.decision, m.err
+}
+
+func (m *mockRateLimitStore) Close() error {
+	return nil
+}
+
+func TestRateLimiter_Take(t *testing.T) {
+	defaultLimit := domain.RateLimit{Rate: 10, Burst: 20}
+	overrides := map[string]domain.RateLimit{
+		"bulk-importer": {Rate: 100, Burst: 200},
+		"ci-bot":        {Rate: 0, Burst: 20},
+	}
+	claims := &contracts.HeimdallClaims{Principal: "bulk-importer", ClientID: "importer", Audience: []string{"lfx-v2-access-check"}}
+
+	tests := []struct {
+		name        string
+		claims      *contracts.HeimdallClaims
+		keyBy       []string
+		storeErr    error
+		wantLimited bool
+		wantKey     string
+		wantLimit   domain.RateLimit
+	}{
+		{
+			name:        "default limit keyed by principal",
+			claims:      &contracts.HeimdallClaims{Principal: "alice"},
+			wantLimited: true,
+			wantKey:     `principal="alice"`,
+			wantLimit:   defaultLimit,
+		},
+		{
+			name:        "override by principal",
+			claims:      claims,
+			wantLimited: true,
+			wantKey:     `principal="bulk-importer"`,
+			wantLimit:   overrides["bulk-importer"],
+		},
+		{
+			name:        "keyed by principal and client",
+			claims:      claims,
+			keyBy:       []string{constants.RateLimitKeyPrincipal, constants.RateLimitKeyClientID},
+			wantLimited: true,
+			wantKey:     `principal="bulk-importer" client_id="importer"`,
+			wantLimit:   overrides["bulk-importer"],
+		},
+		{
+			name:        "keyed by audience",
+			claims:      claims,
+			keyBy:       []string{constants.RateLimitKeyAudience},
+			wantLimited: true,
+			wantKey:     `audience="lfx-v2-access-check"`,
+			wantLimit:   overrides["bulk-importer"],
+		},
+		{
+			name:   "zero-rate override exempts the principal",
+			claims: &contracts.HeimdallClaims{Principal: "ci-bot"},
+		},
+		{
+			name:     "store failure lets the request through",
+			claims:   &contracts.HeimdallClaims{Principal: "alice"},
+			storeErr: errors.New("nats: timeout"),
+			wantKey:  `principal="alice"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockRateLimitStore{decision: domain.RateLimitDecision{Allowed: true}, err: tc.storeErr}
+			limiter := NewRateLimiter(store, defaultLimit, overrides, tc.keyBy)
+
+			_, limited := limiter.Take(context.Background(), tc.claims)
+			if limited != tc.wantLimited {
+				t.Errorf("limited = %v; want %v", limited, tc.wantLimited)
+			}
+			if tc.wantKey == "" {
+				if len(store.keys) != 0 {
+					t.Errorf("store was consulted for an unlimited caller: %v", store.keys)
+				}
+				return
+			}
+			if len(store.keys) != 1 || store.keys[0] != tc.wantKey {
+				t.Fatalf("store keys = %q; want [%q]", store.keys, tc.wantKey)
+			}
+			if tc.wantLimited && store.limits[0] != tc.wantLimit {
+				t.Errorf("limit = %+v; want %+v", store.limits[0], tc.wantLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/cache"
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// tokenCacheLookups counts validated-token cache lookups by result ("hit" or
+// "miss").
+var tokenCacheLookups, _ = meter.Int64Counter("access_check.token_cache.lookups",
+	metric.WithDescription("Validated-token cache lookups by result"),
+	metric.WithUnit("{lookup}"),
+)
+
+// tokenCache remembers the claims of validated tokens so that a token reused
+// across requests has its signature verified once. Entries are keyed by the
+// SHA-256 of the token, so no token is kept in memory, and are served until
+// the token's expiry less the allowed clock skew.
+type tokenCache struct {
+	lru *cache.LRU[[sha256.Size]byte, contracts.HeimdallClaims]
+
+	// now is the clock tokens expire by; tests replace it.
+	now func() time.Time
+}
+
+// newTokenCache creates a cache of at most size tokens, each kept for at most
+// maxTTL. A non-positive size or maxTTL returns nil, which disables caching.
+func newTokenCache(size int, maxTTL time.Duration) *tokenCache {
+	if size <= 0 || maxTTL <= 0 {
+		return nil
+	}
+	return &tokenCache{
+		lru: cache.New[[sha256.Size]byte, contracts.HeimdallClaims](size, maxTTL),
+		now: time.Now,
+	}
+}
+
+// validate returns the claims cached for token, or validates it with authRepo
+// and caches the claims of a token that expires.
+func (c *tokenCache) validate(ctx context.Context, authRepo contracts.AuthRepository, token string) (*contracts.HeimdallClaims, error) {
+	key := sha256.Sum256([]byte(token))
+	if claims, ok := c.lru.Get(key); ok && c.now().Before(claims.ExpiresAt.Add(-constants.JWTClockSkew)) {
+		tokenCacheLookups.Add(ctx, 1, cacheHit)
+		return &claims, nil
+	}
+	tokenCacheLookups.Add(ctx, 1, cacheMiss)
+
+	claims, err := authRepo.ValidateToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	// Tokens without an expiry are never cached: nothing bounds their claims.
+	if !claims.ExpiresAt.IsZero() && c.now().Before(claims.ExpiresAt.Add(-constants.JWTClockSkew)) {
+		c.lru.Add(key, *claims)
+	}
+	return claims, nil
+}
@@ -0,0 +1,93 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
+)
+
+func TestTokenCache_Validate(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		err       error
+		advance   time.Duration
+		wantCalls int
+	}{
+		{name: "second request served from cache", expiresAt: now.Add(time.Hour), wantCalls: 1},
+		{name: "revalidated once near expiry", expiresAt: now.Add(time.Minute), advance: 56 * time.Second, wantCalls: 2},
+		{name: "token expiring within the skew is not cached", expiresAt: now.Add(3 * time.Second), wantCalls: 2},
+		{name: "token without expiry is not cached", wantCalls: 2},
+		{name: "failures are not cached", expiresAt: now.Add(time.Hour), err: errors.New("invalid signature"), wantCalls: 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			authRepo := &mockAuthRepository{
+				validateTokenFunc: func(_ context.Context, _ string) (*contracts.HeimdallClaims, error) {
+					calls++
+					if tc.err != nil {
+						return nil, tc.err
+					}
+					return &contracts.HeimdallClaims{Principal: "alice", ExpiresAt: tc.expiresAt}, nil
+				},
+			}
+			clock := now
+			c := newTokenCache(10, 5*time.Minute)
+			c.now = func() time.Time { return clock }
+
+			for i := range 2 {
+				claims, err := c.validate(context.Background(), authRepo, "header.claims.signature")
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("request %d: err = %v; want %v", i+1, err, tc.err)
+				}
+				if err == nil && claims.Principal != "alice" {
+					t.Errorf("request %d: principal = %q; want %q", i+1, claims.Principal, "alice")
+				}
+				clock = clock.Add(tc.advance)
+			}
+			if calls != tc.wantCalls {
+				t.Errorf("ValidateToken calls = %d; want %d", calls, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestTokenCache_KeysByToken(t *testing.T) {
+	authRepo := &mockAuthRepository{
+		validateTokenFunc: func(_ context.Context, token string) (*contracts.HeimdallClaims, error) {
+			return &contracts.HeimdallClaims{Principal: token, ExpiresAt: time.Now().Add(time.Hour)}, nil
+		},
+	}
+	c := newTokenCache(10, time.Minute)
+
+	for _, token := range []string{"alice", "bob", "alice"} {
+		claims, err := c.validate(context.Background(), authRepo, token)
+		if err != nil {
+			t.Fatalf("validate(%q) failed: %v", token, err)
+		}
+		if claims.Principal != token {
+			t.Errorf("validate(%q) principal = %q", token, claims.Principal)
+		}
+	}
+	if got := c.lru.Len(); got != 2 {
+		t.Errorf("cached tokens = %d; want 2", got)
+	}
+}
+
+func TestNewTokenCache_Disabled(t *testing.T) {
+	if c := newTokenCache(0, time.Minute); c != nil {
+		t.Error("expected a zero size to disable the token cache")
+	}
+	if c := newTokenCache(10, 0); c != nil {
+		t.Error("expected a zero TTL to disable the token cache")
+	}
+}
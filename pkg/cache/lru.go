@@ -0,0 +1,212 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+// Package cache provides a bounded in-memory LRU cache with per-entry expiry.
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Stats reports the lookups served by an LRU since it was created.
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+	Len    int
+}
+
+// LRU is a fixed-size, least-recently-used cache whose entries expire a fixed
+// TTL after they were added. It is safe for concurrent use.
+type LRU[K comparable, V any] struct {
+	mu    sync.Mutex
+	size  int
+	ttl   time.Duration
+	ll    *list.List
+	items map[K]*list.Element
+
+	// maxAge is how long after being added an expired entry is kept for
+	// GetStale; it is never less than ttl.
+	maxAge time.Duration
+
+	hits   uint64
+	misses uint64
+
+	// generation is bumped by every RemoveFunc and Purge, so AddIfGeneration
+	// can refuse values computed before an invalidation.
+	generation uint64
+
+	// now is the clock used for expiry; tests replace it.
+	now func() time.Time
+}
+
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	addedAt time.Time
+}
+
+// New creates an LRU holding at most size entries, each for ttl. The caller
+// must pass a positive size and ttl.
+func New[K comparable, V any](size int, ttl time.Duration) *LRU[K, V] {
+	return &LRU[K, V]{
+		size:   size,
+		ttl:    ttl,
+		ll:     list.New(),
+		items:  make(map[K]*list.Element, size),
+		maxAge: ttl,
+		now:    time.Now,
+	}
+}
+
+// KeepStale keeps expired entries until maxAge after they were added, so
+// GetStale can still return them. Get keeps reporting them as misses. A
+// maxAge shorter than the TTL keeps nothing past expiry.
+func (c *LRU[K, V]) KeepStale(maxAge time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.maxAge = max(maxAge, c.ttl)
+}
+
+// Get returns the value cached for key and marks it recently used. Expired
+// entries are removed and reported as a miss.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*entry[K, V])
+		age := c.now().Sub(e.addedAt)
+		if age < c.ttl {
+			c.ll.MoveToFront(el)
+			c.hits++
+			return e.value, true
+		}
+		if age >= c.maxAge {
+			c.removeElement(el)
+		}
+	}
+
+	c.misses++
+	var zero V
+	return zero, false
+}
+
+// GetStale returns the value cached for key and how long ago it was added,
+// whether or not it has expired, as long as it is younger than the age set by
+// KeepStale. It does not count as a hit or miss, nor mark the entry used.
+func (c *LRU[K, V]) GetStale(key K) (V, time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*entry[K, V])
+		if age := c.now().Sub(e.addedAt); age < c.maxAge {
+			return e.value, age, true
+		}
+		c.removeElement(el)
+	}
+
+	var zero V
+	return zero, 0, false
+}
+
+// Add caches value for key, replacing any existing entry and restarting its
+// TTL. The least recently used entry is evicted when the cache is full.
+func (c *LRU[K, V]) Add(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.addLocked(key, value)
+}
+
+func (c *LRU[K, V]) addLocked(key K, value V) {
+	now := c.now()
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*entry[K, V])
+		e.value = value
+		e.addedAt = now
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&entry[K, V]{key: key, value: value, addedAt: now})
+	if c.ll.Len() > c.size {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// Generation returns the invalidation generation, which changes with every
+// RemoveFunc and Purge call. Read it before computing a value to cache and
+// pass it to AddIfGeneration.
+func (c *LRU[K, V]) Generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// AddIfGeneration is Add, unless RemoveFunc or Purge ran since gen was read
+// with Generation: value may then predate the invalidation and is dropped.
+// It reports whether value was cached.
+func (c *LRU[K, V]) AddIfGeneration(key K, value V, gen uint64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generation != gen {
+		return false
+	}
+	c.addLocked(key, value)
+	return true
+}
+
+// RemoveFunc removes every entry for which match returns true and reports how
+// many were removed.
+func (c *LRU[K, V]) RemoveFunc(match func(key K, value V) bool) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	removed := 0
+	for el := c.ll.Front(); el != nil; {
+		next := el.Next()
+		if e := el.Value.(*entry[K, V]); match(e.key, e.value) {
+			c.removeElement(el)
+			removed++
+		}
+		el = next
+	}
+	return removed
+}
+
+// Purge removes every entry and reports how many were removed. Hit and miss
+// counts are kept.
+func (c *LRU[K, V]) Purge() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	removed := c.ll.Len()
+	c.ll.Init()
+	clear(c.items)
+	return removed
+}
+
+// Len returns the number of cached entries, including expired entries that
+// have not been looked up since they expired and entries kept by KeepStale.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// Stats returns the hit and miss counts and the current number of entries.
+func (c *LRU[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return Stats{Hits: c.hits, Misses: c.misses, Len: c.ll.Len()}
+}
+
+func (c *LRU[K, V]) removeElement(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*entry[K, V]).key)
+}
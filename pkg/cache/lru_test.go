@@ -0,0 +1,233 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a manually advanced clock for expiry tests.
+type fakeClock struct {
+	now time.Time
+}
+
+func (f *fakeClock) Now() time.Time { return f.now }
+
+func newTestLRU(size int, ttl time.Duration) (*LRU[string, int], *fakeClock) {
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	c := New[string, int](size, ttl)
+	c.now = clock.Now
+	return c, clock
+}
+
+func TestLRU_GetAdd(t *testing.T) {
+	c, _ := newTestLRU(2, time.Minute)
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("expected miss on empty cache")
+	}
+	c.Add("a", 1)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("Get(a) = %d, %v; want 1, true", v, ok)
+	}
+	c.Add("a", 2)
+	if v, _ := c.Get("a"); v != 2 {
+		t.Errorf("Get(a) after replace = %d; want 2", v)
+	}
+
+	stats := c.Stats()
+	if stats.Hits != 2 || stats.Misses != 1 || stats.Len != 1 {
+		t.Errorf("Stats() = %+v; want 2 hits, 1 miss, 1 entry", stats)
+	}
+}
+
+func TestLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	c, _ := newTestLRU(2, time.Minute)
+
+	c.Add("a", 1)
+	c.Add("b", 2)
+	c.Get("a") // b is now the least recently used
+	c.Add("c", 3)
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := c.Get(key); !ok {
+			t.Errorf("expected %s to be cached", key)
+		}
+	}
+	if c.Len() != 2 {
+		t.Errorf("Len() = %d; want 2", c.Len())
+	}
+}
+
+func TestLRU_Expiry(t *testing.T) {
+	tests := []struct {
+		name    string
+		advance time.Duration
+		wantHit bool
+	}{
+		{"before ttl", 59 * time.Second, true},
+		{"at ttl", time.Minute, false},
+		{"after ttl", 2 * time.Minute, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, clock := newTestLRU(10, time.Minute)
+			c.Add("a", 1)
+			clock.now = clock.now.Add(tt.advance)
+
+			if _, ok := c.Get("a"); ok != tt.wantHit {
+				t.Errorf("Get(a) hit = %v; want %v", ok, tt.wantHit)
+			}
+			if !tt.wantHit && c.Len() != 0 {
+				t.Errorf("expected expired entry to be removed, Len() = %d", c.Len())
+			}
+		})
+	}
+}
+
+func TestLRU_AddRestartsTTL(t *testing.T) {
+	c, clock := newTestLRU(10, time.Minute)
+	c.Add("a", 1)
+	clock.now = clock.now.Add(45 * time.Second)
+	c.Add("a", 1)
+	clock.now = clock.now.Add(45 * time.Second)
+
+	if _, ok := c.Get("a"); !ok {
+		t.Error("expected re-added entry to still be cached")
+	}
+}
+
+func TestLRU_KeepStale(t *testing.T) {
+	tests := []struct {
+		name      string
+		advance   time.Duration
+		wantFresh bool
+		wantStale bool
+	}{
+		{"fresh", 30 * time.Second, true, true},
+		{"expired but kept", 2 * time.Minute, false, true},
+		{"past max age", 5 * time.Minute, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, clock := newTestLRU(10, time.Minute)
+			c.KeepStale(5 * time.Minute)
+			c.Add("a", 1)
+			clock.now = clock.now.Add(tt.advance)
+
+			if _, ok := c.Get("a"); ok != tt.wantFresh {
+				t.Errorf("Get(a) hit = %v; want %v", ok, tt.wantFresh)
+			}
+			v, age, ok := c.GetStale("a")
+			if ok != tt.wantStale {
+				t.Fatalf("GetStale(a) ok = %v; want %v", ok, tt.wantStale)
+			}
+			if ok && (v != 1 || age != tt.advance) {
+				t.Errorf("GetStale(a) = %d, %v; want 1, %v", v, age, tt.advance)
+			}
+		})
+	}
+}
+
+func TestLRU_GetStaleWithoutKeepStale(t *testing.T) {
+	c, clock := newTestLRU(10, time.Minute)
+	c.Add("a", 1)
+	clock.now = clock.now.Add(time.Minute)
+
+	if _, _, ok := c.GetStale("a"); ok {
+		t.Error("expected expired entry not to be returned without KeepStale")
+	}
+}
+
+func TestLRU_RemoveFunc(t *testing.T) {
+	c, _ := newTestLRU(10, time.Minute)
+	c.Add("a", 1)
+	c.Add("b", 2)
+	c.Add("c", 3)
+
+	if removed := c.RemoveFunc(func(_ string, v int) bool { return v%2 == 1 }); removed != 2 {
+		t.Errorf("RemoveFunc removed %d entries; want 2", removed)
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Error("expected b to remain cached")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := c.Get(key); ok {
+			t.Errorf("expected %s to be removed", key)
+		}
+	}
+
+	if removed := c.Purge(); removed != 1 {
+		t.Errorf("Purge removed %d entries; want 1", removed)
+	}
+	if c.Len() != 0 {
+		t.Errorf("Len() after Purge = %d; want 0", c.Len())
+	}
+	c.Add("d", 4)
+	if _, ok := c.Get("d"); !ok {
+		t.Error("expected cache to be usable after Purge")
+	}
+}
+
+func TestLRU_AddIfGeneration(t *testing.T) {
+	tests := []struct {
+		name       string
+		invalidate func(c *LRU[string, int])
+		wantCached bool
+	}{
+		{name: "no invalidation", invalidate: func(*LRU[string, int]) {}, wantCached: true},
+		{name: "plain add", invalidate: func(c *LRU[string, int]) { c.Add("b", 2) }, wantCached: true},
+		{name: "remove matching nothing", invalidate: func(c *LRU[string, int]) {
+			c.RemoveFunc(func(string, int) bool { return false })
+		}},
+		{name: "purge", invalidate: func(c *LRU[string, int]) { c.Purge() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newTestLRU(10, time.Minute)
+			gen := c.Generation()
+			tt.invalidate(c)
+
+			if added := c.AddIfGeneration("a", 1, gen); added != tt.wantCached {
+				t.Errorf("AddIfGeneration() = %v; want %v", added, tt.wantCached)
+			}
+			if _, ok := c.Get("a"); ok != tt.wantCached {
+				t.Errorf("cached = %v; want %v", ok, tt.wantCached)
+			}
+		})
+	}
+}
+
+func TestLRU_Concurrent(t *testing.T) {
+	c := New[int, int](64, time.Minute)
+
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				c.Add(i%128, w)
+				c.Get((i + w) % 128)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if c.Len() > 64 {
+		t.Errorf("Len() = %d exceeds size 64", c.Len())
+	}
+	stats := c.Stats()
+	if stats.Hits+stats.Misses != 8000 {
+		t.Errorf("expected 8000 lookups, got %d", stats.Hits+stats.Misses)
+	}
+}
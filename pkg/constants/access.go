@@ -12,3 +12,61 @@ const (
 	// AccessFalse indicates permission is denied.
 	AccessFalse = "false"
 )
+
+// objectTypeGrammar is the unanchored grammar for object types, shared by
+// ObjectTypePattern and ObjectPattern so the two cannot drift apart.
+const objectTypeGrammar = `[a-z][a-z0-9_]*`
+
+// Access check request grammar. ObjectTypePattern, ObjectPattern and
+// RelationPattern are shared by the Goa design and internal/domain so the API
+// and the service enforce the same grammar.
+const (
+	// ObjectTypePattern is the grammar for the type part of an object.
+	ObjectTypePattern = `^` + objectTypeGrammar + `$`
+	// ObjectPattern is the strict "type:id" grammar for objects. None of the
+	// tuple delimiters ('@', '#', ':') or whitespace can appear in the id.
+	ObjectPattern = `^` + objectTypeGrammar + `:[A-Za-z0-9][A-Za-z0-9._~|+=-]*$`
+	// RelationPattern is the strict grammar for relation names.
+	RelationPattern = `^[a-z][a-z0-9_]*$`
+)
+
+// Access check request grammar limits. Each request entry must be a
+// "type:id#relation" token; these mirror OpenFGA's own field limits.
+const (
+	// MaxObjectLength is the maximum length of the "type:id" part of a request.
+	MaxObjectLength = 256
+	// MaxRelationLength is the maximum length of the relation part of a request.
+	MaxRelationLength = 50
+)
+
+// my-grants paging limits. Every read_tuples reply is bounded by the page size
+// so it stays well under the NATS max payload.
+const (
+	// DefaultGrantsPageSize is the page size used when the caller sends none.
+	DefaultGrantsPageSize = 100
+	// MaxGrantsPageSize is the largest page size a caller may request.
+	MaxGrantsPageSize = 1000
+	// MaxContinuationTokenLength bounds the opaque continuation token.
+	MaxContinuationTokenLength = 1024
+	// MaxGrantsObjectTypes is the largest number of object types a single
+	// my-grants request may ask for.
+	MaxGrantsObjectTypes = 20
+)
+
+// Contextual access check limits.
+const (
+	// MaxContextualTuples mirrors OpenFGA's limit on contextual tuples per check.
+	MaxContextualTuples = 100
+	// ContextualCheckPayloadVersion is the version of the JSON access-check
+	// payload used when a call carries contextual tuples or condition context.
+	ContextualCheckPayloadVersion = "1"
+)
+
+// Access check batch limits. Calls above the chunk size are split into several
+// access-check messages so each stays well under the NATS max payload and
+// DefaultNATSTimeout.
+const (
+	// MaxCheckRequests is the largest number of entries a single check-access
+	// call may carry.
+	MaxCheckRequests = 1000
+)
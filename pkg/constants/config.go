@@ -4,6 +4,8 @@
 // Package constants defines configuration-related constants.
 package constants
 
+import "time"
+
 // Configuration constants
 const (
 	// Server environment variables
@@ -16,9 +18,82 @@ const (
 	EnvAudience = "AUDIENCE"
 	EnvIssuer   = "ISSUER"
 
+	// EnvJWTAlgorithms lists the signing algorithms accepted from ISSUER,
+	// comma-separated.
+	EnvJWTAlgorithms = "JWT_ALGORITHMS"
+
+	// EnvTrustedIssuersPath points to a JSON file listing every trusted
+	// issuer; it replaces JWKS_URL, ISSUER, AUDIENCE and JWT_ALGORITHMS when
+	// set.
+	EnvTrustedIssuersPath = "TRUSTED_ISSUERS_PATH"
+
 	// Messaging environment variables
 	EnvNATSURL = "NATS_URL"
 
+	// Authorization environment variables
+	EnvListUsersRelation   = "LIST_USERS_RELATION"
+	EnvPermissionModelPath = "PERMISSION_MODEL_PATH"
+
+	// EnvContextualTupleClients lists the client IDs allowed to send
+	// contextual tuples and condition context, comma-separated. Unset, nobody
+	// may.
+	EnvContextualTupleClients = "CONTEXTUAL_TUPLE_CLIENTS"
+
+	// Cache environment variables
+	EnvDecisionCacheSize  = "DECISION_CACHE_SIZE"
+	EnvDecisionCacheTTL   = "DECISION_CACHE_TTL"
+	EnvGrantsCacheSize    = "GRANTS_CACHE_SIZE"
+	EnvGrantsCacheTTL     = "GRANTS_CACHE_TTL"
+	EnvTokenCacheSize     = "TOKEN_CACHE_SIZE"
+	EnvTokenCacheTTL      = "TOKEN_CACHE_TTL"
+	EnvTupleChangeSubject = "TUPLE_CHANGE_SUBJECT"
+
+	// Degraded mode environment variables
+	EnvDegradedMaxStaleness = "DEGRADED_MAX_STALENESS"
+	EnvDegradedMissPolicy   = "DEGRADED_MISS_POLICY"
+
+	// Request timeout environment variables
+	EnvRequestTimeoutMax = "REQUEST_TIMEOUT_MAX"
+
+	// Concurrency limit environment variables
+	EnvConcurrencyLimitMax      = "CONCURRENCY_LIMIT_MAX"
+	EnvConcurrencyLimitMin      = "CONCURRENCY_LIMIT_MIN"
+	EnvConcurrencyTargetLatency = "CONCURRENCY_TARGET_LATENCY"
+	EnvConcurrencyQueueTimeout  = "CONCURRENCY_QUEUE_TIMEOUT"
+	EnvConcurrencyMaxQueue      = "CONCURRENCY_MAX_QUEUE"
+
+	// Rate limit environment variables
+	EnvRateLimitRate      = "RATE_LIMIT_RATE"
+	EnvRateLimitBurst     = "RATE_LIMIT_BURST"
+	EnvRateLimitKey       = "RATE_LIMIT_KEY"
+	EnvRateLimitOverrides = "RATE_LIMIT_OVERRIDES"
+	EnvRateLimitStore     = "RATE_LIMIT_STORE"
+	EnvRateLimitKVBucket  = "RATE_LIMIT_KV_BUCKET"
+
+	// Retry environment variables
+	EnvNATSRetryMaxAttempts       = "NATS_RETRY_MAX_ATTEMPTS"
+	EnvNATSRetryInitialBackoff    = "NATS_RETRY_INITIAL_BACKOFF"
+	EnvNATSRetryMaxBackoff        = "NATS_RETRY_MAX_BACKOFF"
+	EnvNATSRetryMinAttemptTimeout = "NATS_RETRY_MIN_ATTEMPT_TIMEOUT"
+
+	// Hedging environment variables
+	EnvNATSHedgePercentile    = "NATS_HEDGE_PERCENTILE"
+	EnvNATSHedgeMinDelay      = "NATS_HEDGE_MIN_DELAY"
+	EnvNATSHedgeBudgetPercent = "NATS_HEDGE_BUDGET_PERCENT"
+
+	// Circuit breaker environment variables
+	EnvCircuitBreakerFailureThreshold = "CIRCUIT_BREAKER_FAILURE_THRESHOLD"
+	EnvCircuitBreakerOpenTimeout      = "CIRCUIT_BREAKER_OPEN_TIMEOUT"
+	EnvCircuitBreakerMinTimeout       = "CIRCUIT_BREAKER_MIN_TIMEOUT"
+
+	// Batching environment variables
+	EnvCheckBatchWindow  = "CHECK_BATCH_WINDOW"
+	EnvCheckBatchMaxSize = "CHECK_BATCH_MAX_SIZE"
+
+	// Chunking environment variables
+	EnvCheckChunkSize        = "CHECK_CHUNK_SIZE"
+	EnvCheckChunkParallelism = "CHECK_CHUNK_PARALLELISM"
+
 	// Server defaults
 	DefaultHost     = "0.0.0.0"
 	DefaultHTTPPort = "8080"
@@ -30,4 +105,110 @@ const (
 
 	// Messaging defaults
 	DefaultNATSURL = "nats://nats:4222"
+
+	// DefaultListUsersRelation is the relation a caller must hold on an object
+	// to list the users of that object.
+	DefaultListUsersRelation = "writer"
+
+	// DefaultDecisionCacheSize is the maximum number of access-check decisions
+	// cached in process. Zero disables the cache: serving cached decisions is
+	// opt-in.
+	DefaultDecisionCacheSize = 0
+
+	// DefaultDecisionCacheTTL is how long a cached access-check decision is
+	// served before it is checked again.
+	DefaultDecisionCacheTTL = 5 * time.Second
+
+	// DefaultGrantsCacheSize is the maximum number of my-grants pages cached
+	// in process. Zero disables the cache: serving cached grants is opt-in.
+	DefaultGrantsCacheSize = 0
+
+	// DefaultGrantsCacheTTL is how long a cached my-grants page is served.
+	DefaultGrantsCacheTTL = 5 * time.Second
+
+	// DefaultTokenCacheSize is the maximum number of validated tokens cached
+	// in process. Zero disables the cache.
+	DefaultTokenCacheSize = 10000
+
+	// DefaultTokenCacheTTL caps how long the claims of a validated token are
+	// cached; they are never kept past the token's expiry.
+	DefaultTokenCacheTTL = 5 * time.Minute
+
+	// DefaultTupleChangeSubject is the NATS subject on which fga-sync
+	// publishes tuple writes and deletes. It is only subscribed to while the
+	// decision or grants cache is enabled.
+	DefaultTupleChangeSubject = "lfx.fga-sync.tuples_changed"
+
+	// DefaultDegradedMaxStaleness is how old a cached decision may be and
+	// still be served while fga-sync is unavailable. Zero disables degraded
+	// mode.
+	DefaultDegradedMaxStaleness = time.Duration(0)
+
+	// DegradedMissPolicyUnavailable fails a degraded call with 503 when a
+	// check has no cached decision.
+	DegradedMissPolicyUnavailable = "unavailable"
+
+	// DegradedMissPolicyFailClosed denies checks with no cached decision in
+	// degraded mode.
+	DegradedMissPolicyFailClosed = "fail-closed"
+
+	// DefaultDegradedMissPolicy is the degraded-mode policy for checks with
+	// no cached decision.
+	DefaultDegradedMissPolicy = DegradedMissPolicyUnavailable
+
+	// DefaultRateLimitRate is the number of requests per second each caller
+	// may make on average. Zero disables rate limiting.
+	DefaultRateLimitRate = 0.0
+
+	// DefaultRateLimitBurst is the number of requests a caller may make at
+	// once before the rate applies.
+	DefaultRateLimitBurst = 20
+
+	// RateLimitKeyPrincipal, RateLimitKeyClientID and RateLimitKeyAudience
+	// name the token claims a rate-limit key can be built from.
+	RateLimitKeyPrincipal = "principal"
+	RateLimitKeyClientID  = "client_id"
+	RateLimitKeyAudience  = "audience"
+
+	// DefaultRateLimitKey is the comma-separated list of claims callers are
+	// rate limited by.
+	DefaultRateLimitKey = RateLimitKeyPrincipal
+
+	// RateLimitStoreMemory keeps rate-limit state in process, per replica.
+	RateLimitStoreMemory = "memory"
+
+	// RateLimitStoreNATSKV shares rate-limit state across replicas in a NATS
+	// JetStream key-value bucket.
+	RateLimitStoreNATSKV = "nats-kv"
+
+	// DefaultRateLimitStore is where rate-limit state is kept.
+	DefaultRateLimitStore = RateLimitStoreMemory
+
+	// DefaultRateLimitKVBucket is the NATS key-value bucket holding shared
+	// rate-limit state.
+	DefaultRateLimitKVBucket = "access-check-rate-limits"
+
+	// DefaultRateLimitMaxKeys is the maximum number of callers whose state
+	// the in-memory store keeps; the least recently seen are dropped first.
+	DefaultRateLimitMaxKeys = 100000
+
+	// DefaultRateLimitStateTTL is how long the state of an idle caller is
+	// kept. A caller idle for longer starts again with a full burst.
+	DefaultRateLimitStateTTL = time.Hour
+
+	// DefaultCheckBatchWindow is how long checks of concurrent callers are
+	// collected before they are sent as one message. Zero disables batching.
+	DefaultCheckBatchWindow = time.Duration(0)
+
+	// DefaultCheckBatchMaxSize is the maximum number of tuples in one batched
+	// access-check message.
+	DefaultCheckBatchMaxSize = 100
+
+	// DefaultCheckChunkSize is the maximum number of tuples sent in one
+	// access-check message; larger calls are split into chunks.
+	DefaultCheckChunkSize = 100
+
+	// DefaultCheckChunkParallelism is the maximum number of chunks of one call
+	// in flight at the same time.
+	DefaultCheckChunkParallelism = 4
 )
@@ -13,12 +13,23 @@ const (
 	ErrMsgJWTValidationFailed       = "JWT validation failed"
 	ErrMsgJWTValidatorNotInit       = "JWT validator not initialized"
 	ErrMsgPrincipalRequired         = "principal is required"
+	ErrMsgInvalidPrincipal          = "principal contains whitespace or control characters"
 	ErrMsgJWKSEndpointNotAccessible = "JWKS endpoint not accessible"
+	ErrMsgUntrustedIssuer           = "token issuer is not trusted"
+	ErrMsgAlgorithmNotAllowed       = "token signing algorithm is not allowed for its issuer"
+	ErrMsgInvalidTrustedIssuer      = "invalid trusted issuer configuration"
+	ErrMsgUnsupportedAlgorithm      = "unsupported JWT signing algorithm"
 
 	// API and validation errors
 	ErrMsgUnsupportedAPIVersion = "unsupported API version"
+	ErrMsgInvalidAccessRequest  = "invalid access check request"
+	ErrMsgInvalidTuple          = "invalid tuple"
 	ErrMsgServiceDepsUnhealthy  = "service dependencies unhealthy"
 	ErrMsgUnexpectedResponse    = "unexpected response from access check service"
+	ErrMsgResultMismatch        = "access check reply does not match the requested tuples"
+	ErrMsgListUsersForbidden    = "caller does not hold the relation required to list users of this object"
+	ErrMsgUnknownObjectType     = "object type is not in the permission model"
+	ErrMsgContextualForbidden   = "caller is not allowed to send contextual tuples or condition context"
 
 	// NATS connection errors
 	ErrMsgNATSConnNotInit       = "NATS connection not initialized"
@@ -27,8 +38,19 @@ const (
 	ErrMsgNATSConnDraining      = "NATS connection is draining"
 	ErrMsgNATSConnNotResponsive = "NATS connection not responsive"
 	ErrMsgNATSRequestFailed     = "NATS request failed"
+	ErrMsgNATSSubscribeFailed   = "NATS subscribe failed"
 	ErrMsgNATSMaxReconnects     = "NATS max-reconnects exhausted; connection closed"
 	ErrMsgNATSConnUnhealthy     = "NATS connection unhealthy"
+	ErrMsgCircuitOpen           = "messaging circuit breaker is open"
+	ErrMsgRequestTimeout        = "request deadline exceeded before the backend replied"
+
+	// Rate limiting errors
+	ErrMsgRateLimited          = "rate limit exceeded; retry later"
+	ErrMsgInvalidRateLimitRule = "invalid rate limit override"
+	ErrMsgRateLimitContention  = "rate limit state kept changing concurrently"
+
+	// Configuration errors
+	ErrMsgDegradedNeedsCache = "degraded mode needs the decision cache; set " + EnvDecisionCacheSize
 
 	// Repository initialization errors
 	ErrMsgMessagingRepoNotInit = "messaging repository not initialized"
@@ -39,15 +61,33 @@ const (
 var (
 	ErrInvalidAuthContext   = errors.New(ErrMsgInvalidAuthContext)
 	ErrPrincipalRequired    = errors.New(ErrMsgPrincipalRequired)
+	ErrInvalidPrincipal     = errors.New(ErrMsgInvalidPrincipal)
+	ErrInvalidAccessRequest = errors.New(ErrMsgInvalidAccessRequest)
+	ErrInvalidTuple         = errors.New(ErrMsgInvalidTuple)
 	ErrJWTValidatorNotInit  = errors.New(ErrMsgJWTValidatorNotInit)
+	ErrUntrustedIssuer      = errors.New(ErrMsgUntrustedIssuer)
+	ErrAlgorithmNotAllowed  = errors.New(ErrMsgAlgorithmNotAllowed)
+	ErrInvalidTrustedIssuer = errors.New(ErrMsgInvalidTrustedIssuer)
+	ErrUnsupportedAlgorithm = errors.New(ErrMsgUnsupportedAlgorithm)
 	ErrUnexpectedResponse   = errors.New(ErrMsgUnexpectedResponse)
 	ErrInvalidToken         = errors.New("invalid or expired token")
 	ErrAccessCheckFailed    = errors.New("access check failed")
 	ErrReadingTuplesFailed  = errors.New("reading tuples failed")
+	ErrListingObjectsFailed = errors.New("listing objects failed")
+	ErrListingUsersFailed   = errors.New("listing users failed")
+	ErrListUsersForbidden   = errors.New(ErrMsgListUsersForbidden)
+	ErrUnknownObjectType    = errors.New(ErrMsgUnknownObjectType)
+	ErrContextualForbidden  = errors.New(ErrMsgContextualForbidden)
 	ErrNATSConnNotInit      = errors.New(ErrMsgNATSConnNotInit)
 	ErrNATSConnNotActive    = errors.New(ErrMsgNATSConnNotActive)
 	ErrNATSConnClosed       = errors.New(ErrMsgNATSConnClosed)
 	ErrNATSConnDraining     = errors.New(ErrMsgNATSConnDraining)
 	ErrMessagingRepoNotInit = errors.New(ErrMsgMessagingRepoNotInit)
 	ErrAuthRepoNotInit      = errors.New(ErrMsgAuthRepoNotInit)
+	ErrCircuitOpen          = errors.New(ErrMsgCircuitOpen)
+	ErrRequestTimeout       = errors.New(ErrMsgRequestTimeout)
+	ErrRateLimited          = errors.New(ErrMsgRateLimited)
+	ErrInvalidRateLimitRule = errors.New(ErrMsgInvalidRateLimitRule)
+	ErrRateLimitContention  = errors.New(ErrMsgRateLimitContention)
+	ErrDegradedNeedsCache   = errors.New(ErrMsgDegradedNeedsCache)
 )
@@ -11,6 +11,56 @@ const (
 	// RequestIDHeader is the HTTP header name for request ID
 	RequestIDHeader = "X-Request-ID"
 
+	// RetryAfterHeader tells clients how many seconds to wait before retrying
+	// a 503 or 429 response
+	RetryAfterHeader = "Retry-After"
+
+	// RateLimitLimitHeader, RateLimitRemainingHeader and RateLimitResetHeader
+	// report the caller's rate limit: the burst size, the requests left in
+	// it, and the seconds until it is full again
+	RateLimitLimitHeader     = "RateLimit-Limit"
+	RateLimitRemainingHeader = "RateLimit-Remaining"
+	RateLimitResetHeader     = "RateLimit-Reset"
+
+	// StaleResponseHeader is set to "true" on responses that include decisions
+	// served in degraded mode while fga-sync was unavailable
+	StaleResponseHeader = "X-Access-Check-Stale"
+
+	// ContextualResponseHeader is set to "true" on responses whose decisions
+	// were made with the call's contextual tuples
+	ContextualResponseHeader = "X-Access-Check-Contextual"
+
+	// RequestTimeoutHeader lets callers bound how long the service works on a
+	// request, as a Go duration ("500ms") or whole seconds ("5")
+	RequestTimeoutHeader = "X-Request-Timeout"
+
+	// GRPCTimeoutHeader is the gRPC-style alternative to RequestTimeoutHeader
+	// ("500m", "5S")
+	GRPCTimeoutHeader = "Grpc-Timeout"
+
+	// OpenAPIPathPrefix is the path prefix of the served OpenAPI documents
+	OpenAPIPathPrefix = "/_access-check/openapi"
+
+	// DefaultConcurrencyLimitMax is the starting and highest limit on HTTP
+	// requests served at once. Zero disables the concurrency limiter: the
+	// limit adapts to one latency target shared by every route, so slow
+	// list calls could shrink it for cheap checks, and it is opt-in.
+	DefaultConcurrencyLimitMax = 0
+
+	// DefaultConcurrencyLimitMin is the lowest the adaptive limit shrinks to
+	DefaultConcurrencyLimitMin = 10
+
+	// DefaultConcurrencyTargetLatency is the request latency the limit adapts
+	// to: slower requests shrink it, faster ones grow it back
+	DefaultConcurrencyTargetLatency = time.Second
+
+	// DefaultConcurrencyQueueTimeout is how long a request over the limit
+	// waits for a slot before it is shed with 429
+	DefaultConcurrencyQueueTimeout = 100 * time.Millisecond
+
+	// DefaultConcurrencyMaxQueue caps the requests waiting for a slot
+	DefaultConcurrencyMaxQueue = 100
+
 	// DefaultShutdownTimeout is the default timeout for graceful server shutdown
 	DefaultShutdownTimeout = 25 * time.Second
 
@@ -13,6 +13,14 @@ const (
 
 	// ReadTuplesSubject is the NATS subject for reading a user's direct tuples by object type.
 	ReadTuplesSubject = "lfx.access_check.read_tuples"
+
+	// ListObjectsSubject is the NATS subject for listing every object of a type a
+	// user holds a relation on, including relations inherited through parents.
+	ListObjectsSubject = "lfx.access_check.list_objects"
+
+	// ListUsersSubject is the NATS subject for listing the users and usersets
+	// that hold a relation on an object.
+	ListUsersSubject = "lfx.access_check.list_users"
 )
 
 // Messaging constants
@@ -20,6 +28,10 @@ const (
 	// DefaultNATSTimeout is the default timeout for NATS operations
 	DefaultNATSTimeout = 15 * time.Second
 
+	// DefaultRequestTimeoutMax bounds the deadline a caller may ask for, and
+	// is the deadline of requests that do not ask for one.
+	DefaultRequestTimeoutMax = DefaultNATSTimeout
+
 	// DefaultNATSReconnectWait is the default wait time between reconnection attempts
 	DefaultNATSReconnectWait = 2 * time.Second
 
@@ -32,3 +44,58 @@ const (
 	// DefaultResponseSanityCheckBytes is the number of bytes to check for error detection
 	DefaultResponseSanityCheckBytes = 20
 )
+
+// Retry constants
+const (
+	// DefaultNATSRetryMaxAttempts is the total number of attempts for a NATS
+	// request that fails with a transient error. One disables retries.
+	DefaultNATSRetryMaxAttempts = 3
+
+	// DefaultNATSRetryInitialBackoff is the base wait before the first retry.
+	DefaultNATSRetryInitialBackoff = 50 * time.Millisecond
+
+	// DefaultNATSRetryMaxBackoff caps the base wait between retries.
+	DefaultNATSRetryMaxBackoff = time.Second
+
+	// DefaultNATSRetryMinAttemptTimeout is the least time an attempt is given
+	// when a request's deadline is split among its attempts. It matches
+	// DefaultNATSTimeout, so by default the first attempt keeps the whole
+	// budget and timeouts are not retried: only no responders is. Lower it to
+	// leave time for retries after a timeout, at the cost of failing replies
+	// slower than the minimum.
+	DefaultNATSRetryMinAttemptTimeout = DefaultNATSTimeout
+)
+
+// Hedging constants
+const (
+	// DefaultNATSHedgePercentile is the percentile of recent reply latencies
+	// after which a request is hedged. Zero disables hedging.
+	DefaultNATSHedgePercentile = 0
+
+	// MaxNATSHedgePercentile is the highest hedge percentile accepted; the
+	// 100th would wait for the slowest recent reply and never hedge in time.
+	MaxNATSHedgePercentile = 99
+
+	// DefaultNATSHedgeMinDelay is the shortest wait before hedging a request.
+	DefaultNATSHedgeMinDelay = 50 * time.Millisecond
+
+	// DefaultNATSHedgeBudgetPercent caps hedge requests as a percentage of
+	// requests.
+	DefaultNATSHedgeBudgetPercent = 5
+)
+
+// Circuit breaker constants
+const (
+	// DefaultCircuitBreakerFailureThreshold is the number of consecutive failed
+	// NATS requests that opens the circuit. Zero disables the breaker.
+	DefaultCircuitBreakerFailureThreshold = 5
+
+	// DefaultCircuitBreakerOpenTimeout is how long the circuit stays open
+	// before a single probe request is let through.
+	DefaultCircuitBreakerOpenTimeout = 10 * time.Second
+
+	// DefaultCircuitBreakerMinTimeout is the least time a request must have
+	// had to wait for its reply for a timeout to count as a failure. Callers
+	// asking for shorter deadlines cannot open the circuit for everyone else.
+	DefaultCircuitBreakerMinTimeout = time.Second
+)
@@ -13,4 +13,14 @@ const (
 
 	// DefaultJWKSCacheTimeout is the default timeout for JWKS caching
 	DefaultJWKSCacheTimeout = 5 * time.Minute
+
+	// DefaultJWTAlgorithm is the signing algorithm accepted from an issuer
+	// that does not list its own
+	DefaultJWTAlgorithm = "PS256"
+
+	// Default claims the principal, email and client ID are read from when an
+	// issuer does not map them
+	DefaultPrincipalClaim = "principal"
+	DefaultEmailClaim     = "email"
+	DefaultClientIDClaim  = "client_id"
 )
@@ -16,9 +16,23 @@ const (
 	// but we can add an alias here for clarity in service contexts
 	ServiceRequestIDKey ContextKey = RequestIDHeader
 
+	// ResponseHeaderContextKey holds the http.Header of the response being
+	// written, so service methods can set headers the Goa design cannot
+	// express, such as Retry-After.
+	ResponseHeaderContextKey ContextKey = "response_header"
+
 	// API version constants
 	SupportedAPIVersion = "1"
 
+	// StructuredResultsAPIVersion is the check-access API version that returns
+	// parsed decisions instead of tuple-strings.
+	StructuredResultsAPIVersion = "2"
+
+	// CheckAccessResultView and StructuredResultsView are the check-access
+	// result views of API versions 1 and 2.
+	CheckAccessResultView = "default"
+	StructuredResultsView = "v2"
+
 	// Authentication constants
 	BearerTokenPrefix = "Bearer "
 
@@ -26,8 +40,12 @@ const (
 	HealthOKResponse = "OK"
 
 	// Relation building constants
-	UserRelationPrefix = "@user:"
-	RelationSeparator  = "@"
+	RelationSeparator       = "@"
+	ObjectRelationSeparator = "#"
+
+	// ResultFieldSeparator separates a tuple from its true/false decision in
+	// access-check result lines.
+	ResultFieldSeparator = "\t"
 
 	// UserTypePrefix is the OpenFGA user type prefix prepended to a principal.
 	UserTypePrefix = "user:"
@@ -6,8 +6,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/linuxfoundation/lfx-v2-access-check/internal/service"
@@ -22,7 +24,8 @@ func TestAccessCheckEndpoint(t *testing.T) {
 	// Create test service with mock dependencies
 	mockAuthRepo := &MockAuthRepository{}
 	mockMessagingRepo := &MockMessagingRepository{}
-	accessService := service.NewAccessService(mockAuthRepo, mockMessagingRepo)
+	accessService := service.NewAccessService(mockAuthRepo, mockMessagingRepo,
+		service.WithContextualTupleClients([]string{"test-client"}))
 
 	// Create endpoints
 	endpoints := accesssvc.NewEndpoints(accessService)
@@ -71,6 +74,114 @@ func TestAccessCheckEndpoint(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectedError:  false,
 		},
+		{
+			name:   "Valid structured access check request",
+			method: "POST",
+			url:    "/access-check?v=2",
+			headers: map[string]string{
+				"Authorization": "Bearer valid-token",
+				"Content-Type":  "application/json",
+			},
+			body: map[string]interface{}{
+				"requests": []string{constants.ExampleProjectAction, constants.ExampleCommitteeAction},
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  false,
+		},
+		{
+			name:   "Access check with contextual tuples and context",
+			method: "POST",
+			url:    "/access-check?v=1",
+			headers: map[string]string{
+				"Authorization": "Bearer valid-token",
+				"Content-Type":  "application/json",
+			},
+			body: map[string]interface{}{
+				"requests": []string{constants.ExampleProjectAction},
+				"contextual_tuples": []map[string]string{
+					{"object": "project:a27394a3-7a6c-4d0f-9e0f-692d8753924f", "relation": "auditor", "user": "user:auth0|bob"},
+				},
+				"context": map[string]interface{}{"current_time": "2026-01-01T00:00:00Z"},
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  false,
+		},
+		{
+			name:   "Contextual tuple granting the caller",
+			method: "POST",
+			url:    "/access-check?v=1",
+			headers: map[string]string{
+				"Authorization": "Bearer valid-token",
+				"Content-Type":  "application/json",
+			},
+			body: map[string]interface{}{
+				"requests": []string{constants.ExampleProjectAction},
+				"contextual_tuples": []map[string]string{
+					{"object": "project:a27394a3-7a6c-4d0f-9e0f-692d8753924f", "relation": "auditor", "user": "user:test-user"},
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  true,
+		},
+		{
+			name:   "Malformed contextual tuple",
+			method: "POST",
+			url:    "/access-check?v=1",
+			headers: map[string]string{
+				"Authorization": "Bearer valid-token",
+				"Content-Type":  "application/json",
+			},
+			body: map[string]interface{}{
+				"requests": []string{constants.ExampleProjectAction},
+				"contextual_tuples": []map[string]string{
+					{"object": "project:abc#owner", "relation": "auditor", "user": "user:test-user"},
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  true,
+		},
+		{
+			name:   "Large request is chunked",
+			method: "POST",
+			url:    "/access-check?v=1",
+			headers: map[string]string{
+				"Authorization": "Bearer valid-token",
+				"Content-Type":  "application/json",
+			},
+			body: map[string]interface{}{
+				"requests": checkRequests(constants.MaxCheckRequests),
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  false,
+		},
+		{
+			name:   "Too many requests entries",
+			method: "POST",
+			url:    "/access-check?v=1",
+			headers: map[string]string{
+				"Authorization": "Bearer valid-token",
+				"Content-Type":  "application/json",
+			},
+			body: map[string]interface{}{
+				"requests": checkRequests(constants.MaxCheckRequests + 1),
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  true,
+		},
+		{
+			name:   "Unsupported version parameter",
+			method: "POST",
+			url:    "/access-check?v=3",
+			headers: map[string]string{
+				"Authorization": "Bearer valid-token",
+				"Content-Type":  "application/json",
+			},
+			body: map[string]interface{}{
+				"requests": []string{constants.ExampleProjectAction},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  true,
+		},
 		{
 			name:   "Missing authorization header",
 			method: "POST",
@@ -112,6 +223,20 @@ func TestAccessCheckEndpoint(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			expectedError:  true,
 		},
+		{
+			name:   "Malformed request entry",
+			method: "POST",
+			url:    "/access-check?v=1",
+			headers: map[string]string{
+				"Authorization": "Bearer valid-token",
+				"Content-Type":  "application/json",
+			},
+			body: map[string]interface{}{
+				"requests": []string{constants.ExampleProjectAction, constants.ExampleCommitteeAction + "@user:someone-else"},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  true,
+		},
 		{
 			name:   "Empty requests array",
 			method: "POST",
@@ -173,9 +298,16 @@ func TestAccessCheckEndpoint(t *testing.T) {
 					t.Fatalf("Failed to decode response: %v", err)
 				}
 
-				results, ok := response["results"]
+				field, otherField := "results", "decisions"
+				if strings.Contains(tt.url, "v=2") {
+					field, otherField = "decisions", "results"
+				}
+				results, ok := response[field]
 				if !ok {
-					t.Error("Response missing 'results' field")
+					t.Errorf("Response missing '%s' field", field)
+				}
+				if _, ok := response[otherField]; ok {
+					t.Errorf("Response has unexpected '%s' field", otherField)
 				}
 
 				resultsArray, ok := results.([]interface{})
@@ -192,3 +324,12 @@ func TestAccessCheckEndpoint(t *testing.T) {
 		})
 	}
 }
+
+// checkRequests returns n distinct project check requests.
+func checkRequests(n int) []string {
+	requests := make([]string, n)
+	for i := range requests {
+		requests[i] = fmt.Sprintf("project:p%d#viewer", i)
+	}
+	return requests
+}
@@ -0,0 +1,160 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+func TestListObjectsEndpoint(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		authHeader     string
+		natsResponse   []byte
+		expectedStatus int
+		validateBody   func(t *testing.T, body []byte)
+	}{
+		{
+			name:           "Valid request",
+			url:            "/my-objects?v=1&object_type=project&relation=viewer",
+			authHeader:     "Bearer valid-token",
+			natsResponse:   []byte(`{"results":["project:a27394a3-7a6c-4d0f-9e0f-692d8753924f","project:b3c72e18-1a2b-4c3d-8e9f-123456789abc"]}`),
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, body []byte) {
+				var resp struct {
+					Objects []string `json:"objects"`
+				}
+				if err := json.Unmarshal(body, &resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if len(resp.Objects) != 2 {
+					t.Errorf("expected 2 objects, got %d", len(resp.Objects))
+				}
+			},
+		},
+		{
+			name:           "Valid request with empty results",
+			url:            "/my-objects?v=1&object_type=committee&relation=writer",
+			authHeader:     "Bearer valid-token",
+			natsResponse:   []byte(`{"results":[]}`),
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, body []byte) {
+				var resp map[string]interface{}
+				if err := json.Unmarshal(body, &resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				objects, ok := resp["objects"].([]interface{})
+				if !ok {
+					t.Fatal("objects field is missing or not an array")
+				}
+				if len(objects) != 0 {
+					t.Errorf("expected 0 objects, got %d", len(objects))
+				}
+			},
+		},
+		{
+			name:           "Object of another type in reply",
+			url:            "/my-objects?v=1&object_type=project&relation=viewer",
+			authHeader:     "Bearer valid-token",
+			natsResponse:   []byte(`{"results":["committee:b3c72e18-1a2b-4c3d-8e9f-123456789abc"]}`),
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "Backend error",
+			url:            "/my-objects?v=1&object_type=project&relation=viewer",
+			authHeader:     "Bearer valid-token",
+			natsResponse:   []byte(`{"error":"store not found"}`),
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "Missing authorization header",
+			url:            "/my-objects?v=1&object_type=project&relation=viewer",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid token",
+			url:            "/my-objects?v=1&object_type=project&relation=viewer",
+			authHeader:     "Bearer invalid-token",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Missing version parameter",
+			url:            "/my-objects?object_type=project&relation=viewer",
+			authHeader:     "Bearer valid-token",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Missing relation parameter",
+			url:            "/my-objects?v=1&object_type=project",
+			authHeader:     "Bearer valid-token",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid relation — user suffix",
+			url:            "/my-objects?v=1&object_type=project&relation=viewer%40user:bob",
+			authHeader:     "Bearer valid-token",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid object_type — uppercase letters",
+			url:            "/my-objects?v=1&object_type=Project&relation=viewer",
+			authHeader:     "Bearer valid-token",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			natsResp := tt.natsResponse
+			ts := newTestServer(t, &ConfigurableMessagingRepository{
+				RequestFunc: func(_ context.Context, subject string, _ []byte, _ time.Duration) ([]byte, error) {
+					if subject != constants.ListObjectsSubject {
+						t.Errorf("unexpected NATS subject: %s", subject)
+					}
+					return natsResp, nil
+				},
+			})
+			defer ts.Close()
+
+			reqCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, ts.URL+tt.url, nil)
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("failed to make request: %v", err)
+			}
+			defer func() {
+				if err := resp.Body.Close(); err != nil {
+					t.Errorf("failed to close response body: %v", err)
+				}
+			}()
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+
+			if tt.validateBody != nil && resp.StatusCode == http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					t.Fatalf("failed to read response body: %v", err)
+				}
+				tt.validateBody(t, body)
+			}
+		})
+	}
+}
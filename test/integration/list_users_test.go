@@ -0,0 +1,140 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
+)
+
+func TestListUsersEndpoint(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		authHeader     string
+		guardAllowed   bool
+		natsResponse   []byte
+		expectedStatus int
+		validateBody   func(t *testing.T, body []byte)
+	}{
+		{
+			name:           "Valid request",
+			url:            "/object-users?v=1&object=committee:b3c72e18-1a2b-4c3d-8e9f-123456789abc&relation=writer",
+			authHeader:     "Bearer valid-token",
+			guardAllowed:   true,
+			natsResponse:   []byte(`{"results":["user:auth0|alice","project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#writer"]}`),
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, body []byte) {
+				var resp struct {
+					Users    []string `json:"users"`
+					Usersets []string `json:"usersets"`
+				}
+				if err := json.Unmarshal(body, &resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if !slices.Equal(resp.Users, []string{"user:auth0|alice"}) {
+					t.Errorf("unexpected users: %v", resp.Users)
+				}
+				if !slices.Equal(resp.Usersets, []string{"project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#writer"}) {
+					t.Errorf("unexpected usersets: %v", resp.Usersets)
+				}
+			},
+		},
+		{
+			name:           "Caller lacks guard relation",
+			url:            "/object-users?v=1&object=committee:b3c72e18-1a2b-4c3d-8e9f-123456789abc&relation=writer",
+			authHeader:     "Bearer valid-token",
+			guardAllowed:   false,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Invalid token",
+			url:            "/object-users?v=1&object=committee:abc&relation=writer",
+			authHeader:     "Bearer invalid-token",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Missing object parameter",
+			url:            "/object-users?v=1&relation=writer",
+			authHeader:     "Bearer valid-token",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid object — relation suffix",
+			url:            "/object-users?v=1&object=committee:abc%23owner&relation=writer",
+			authHeader:     "Bearer valid-token",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid relation — uppercase letters",
+			url:            "/object-users?v=1&object=committee:abc&relation=Writer",
+			authHeader:     "Bearer valid-token",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newTestServer(t, &ConfigurableMessagingRepository{
+				RequestFunc: func(_ context.Context, subject string, data []byte, _ time.Duration) ([]byte, error) {
+					switch subject {
+					case constants.AccessCheckSubject:
+						decision := constants.AccessFalse
+						if tt.guardAllowed {
+							decision = constants.AccessTrue
+						}
+						return append(data, []byte(constants.ResultFieldSeparator+decision)...), nil
+					case constants.ListUsersSubject:
+						if !tt.guardAllowed {
+							t.Error("list-users must not be requested when the guard check is denied")
+						}
+						return tt.natsResponse, nil
+					default:
+						t.Errorf("unexpected NATS subject: %s", subject)
+						return nil, nil
+					}
+				},
+			})
+			defer ts.Close()
+
+			reqCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, ts.URL+tt.url, nil)
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("failed to make request: %v", err)
+			}
+			defer func() {
+				if err := resp.Body.Close(); err != nil {
+					t.Errorf("failed to close response body: %v", err)
+				}
+			}()
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+
+			if tt.validateBody != nil && resp.StatusCode == http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					t.Fatalf("failed to read response body: %v", err)
+				}
+				tt.validateBody(t, body)
+			}
+		})
+	}
+}
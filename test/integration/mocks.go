@@ -6,9 +6,12 @@ package integration
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"strings"
 	"time"
 
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
 	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
 )
 
@@ -22,6 +25,7 @@ func (m *MockAuthRepository) ValidateToken(_ context.Context, token string) (*co
 		return &contracts.HeimdallClaims{
 			Principal: "test-user",
 			Email:     "test@example.com",
+			ClientID:  "test-client",
 		}, nil
 	}
 	return nil, errors.New("unauthorized")
@@ -32,13 +36,42 @@ func (m *MockAuthRepository) HealthCheck(_ context.Context) error {
 	return nil
 }
 
+// echoAccessCheckReply answers every tuple of an access-check payload,
+// granting project relations and denying everything else. Both the plaintext
+// and the versioned JSON encodings are understood. Tuples are answered in
+// reverse order to mimic fga-sync's unordered replies.
+func echoAccessCheckReply(data []byte) []byte {
+	lines := strings.Split(string(data), "\n")
+	var contextual struct {
+		Checks []string `json:"checks"`
+	}
+	if json.Unmarshal(data, &contextual) == nil {
+		lines = contextual.Checks
+	}
+	reply := make([]string, 0, len(lines))
+	for i := len(lines) - 1; i >= 0; i-- {
+		tuple, err := domain.ParseTuple(lines[i])
+		if err != nil {
+			return []byte(err.Error())
+		}
+		result := domain.CheckResult{Tuple: tuple, Allowed: tuple.ObjectType() == "project"}
+		reply = append(reply, result.String())
+	}
+	return []byte(strings.Join(reply, "\n"))
+}
+
 // MockMessagingRepository provides a test implementation of MessagingRepository
 type MockMessagingRepository struct{}
 
 // Request sends a mock request message and returns a mock response for testing
-func (m *MockMessagingRepository) Request(_ context.Context, _ string, _ []byte, _ time.Duration) ([]byte, error) {
+func (m *MockMessagingRepository) Request(_ context.Context, _ string, data []byte, _ time.Duration) ([]byte, error) {
 	// Return mock response
-	return []byte("project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#auditor@user:auth0|alice\ttrue\ncommittee:b3c72e18-1a2b-4c3d-8e9f-123456789abc#writer@user:auth0|alice\tfalse"), nil
+	return echoAccessCheckReply(data), nil
+}
+
+// Subscribe is a no-op for testing; no messages are ever delivered.
+func (m *MockMessagingRepository) Subscribe(_ string, _ contracts.MessageHandler) error {
+	return nil
 }
 
 // Close closes the mock messaging connection (no-op for testing)
@@ -62,7 +95,12 @@ func (m *ConfigurableMessagingRepository) Request(ctx context.Context, subject s
 	if m.RequestFunc != nil {
 		return m.RequestFunc(ctx, subject, data, timeout)
 	}
-	return []byte("project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#auditor@user:auth0|alice\ttrue\ncommittee:b3c72e18-1a2b-4c3d-8e9f-123456789abc#writer@user:auth0|alice\tfalse"), nil
+	return echoAccessCheckReply(data), nil
+}
+
+// Subscribe is a no-op for testing; no messages are ever delivered.
+func (m *ConfigurableMessagingRepository) Subscribe(_ string, _ contracts.MessageHandler) error {
+	return nil
 }
 
 // Close is a no-op for testing.
@@ -13,16 +13,13 @@ import (
 
 	accesssvc "github.com/linuxfoundation/lfx-v2-access-check/gen/access_svc"
 	accesssvcsvr "github.com/linuxfoundation/lfx-v2-access-check/gen/http/access_svc/server"
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain/contracts"
 	"github.com/linuxfoundation/lfx-v2-access-check/internal/service"
 	"github.com/linuxfoundation/lfx-v2-access-check/pkg/constants"
 	goahttp "goa.design/goa/v3/http"
 )
 
-func newTestServer(t *testing.T, messagingRepo interface {
-	Request(ctx context.Context, subject string, data []byte, timeout time.Duration) ([]byte, error)
-	Close() error
-	HealthCheck(ctx context.Context) error
-}) *httptest.Server {
+func newTestServer(t *testing.T, messagingRepo contracts.MessagingRepository) *httptest.Server {
 	t.Helper()
 	accessService := service.NewAccessService(&MockAuthRepository{}, messagingRepo)
 	endpoints := accesssvc.NewEndpoints(accessService)
@@ -96,6 +93,71 @@ func TestMyGrantsEndpoint(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:           "Paged request returns continuation token",
+			url:            "/my-grants?v=1&object_type=project&page_size=1&continuation_token=page-1",
+			authHeader:     "Bearer valid-token",
+			natsResponse:   []byte(`{"results":["project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#auditor@user:auth0|alice"],"continuation_token":"page-2"}`),
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, body []byte) {
+				var resp struct {
+					Grants            []string `json:"grants"`
+					ContinuationToken string   `json:"continuation_token"`
+				}
+				if err := json.Unmarshal(body, &resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if len(resp.Grants) != 1 {
+					t.Errorf("expected 1 grant, got %d", len(resp.Grants))
+				}
+				if resp.ContinuationToken != "page-2" {
+					t.Errorf("expected continuation token %q, got %q", "page-2", resp.ContinuationToken)
+				}
+			},
+		},
+		{
+			name:           "Multiple object types with relation filter",
+			url:            "/my-grants?v=1&object_type=project&object_type=committee&relation=writer",
+			authHeader:     "Bearer valid-token",
+			natsResponse:   []byte(`{"results":["project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#writer@user:auth0|alice","committee:b3c72e18-1a2b-4c3d-8e9f-123456789abc#writer@user:auth0|alice","project:a27394a3-7a6c-4d0f-9e0f-692d8753924f#auditor@user:auth0|alice"]}`),
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, body []byte) {
+				var resp struct {
+					Grants       []string            `json:"grants"`
+					GrantsByType map[string][]string `json:"grants_by_type"`
+				}
+				if err := json.Unmarshal(body, &resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if len(resp.Grants) != 2 {
+					t.Errorf("expected 2 grants, got %d", len(resp.Grants))
+				}
+				if len(resp.GrantsByType["project"]) != 1 || len(resp.GrantsByType["committee"]) != 1 {
+					t.Errorf("expected one grant per type, got %v", resp.GrantsByType)
+				}
+			},
+		},
+		{
+			name:           "Invalid relation filter",
+			url:            "/my-grants?v=1&object_type=project&relation=Writer",
+			authHeader:     "Bearer valid-token",
+			natsResponse:   nil,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Page size above maximum",
+			url:            "/my-grants?v=1&object_type=project&page_size=1001",
+			authHeader:     "Bearer valid-token",
+			natsResponse:   nil,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Page size zero",
+			url:            "/my-grants?v=1&object_type=project&page_size=0",
+			authHeader:     "Bearer valid-token",
+			natsResponse:   nil,
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name:           "Missing authorization header",
 			url:            "/my-grants?v=1&object_type=project",
@@ -132,8 +194,8 @@ func TestMyGrantsEndpoint(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
-			name:           "Invalid object_type — trailing underscore",
-			url:            "/my-grants?v=1&object_type=bad_",
+			name:           "Invalid object_type — leading digit",
+			url:            "/my-grants?v=1&object_type=1project",
 			authHeader:     "Bearer valid-token",
 			natsResponse:   nil,
 			expectedStatus: http.StatusBadRequest,
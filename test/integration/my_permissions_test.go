@@ -0,0 +1,117 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
+)
+
+func TestMyPermissionsEndpoint(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		authHeader     string
+		expectedStatus int
+		validateBody   func(t *testing.T, body []byte)
+	}{
+		{
+			name:           "Relations held on a project",
+			url:            "/my-permissions?v=1&object=project:a27394a3-7a6c-4d0f-9e0f-692d8753924f",
+			authHeader:     "Bearer valid-token",
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, body []byte) {
+				var resp struct {
+					Object    string   `json:"object"`
+					Relations []string `json:"relations"`
+				}
+				if err := json.Unmarshal(body, &resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				want, _ := domain.DefaultPermissionModel().Relations("project")
+				if len(resp.Relations) != len(want) {
+					t.Errorf("expected relations %v, got %v", want, resp.Relations)
+				}
+			},
+		},
+		{
+			name:           "No relations held on a committee",
+			url:            "/my-permissions?v=1&object=committee:b3c72e18-1a2b-4c3d-8e9f-123456789abc",
+			authHeader:     "Bearer valid-token",
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, body []byte) {
+				var resp map[string]interface{}
+				if err := json.Unmarshal(body, &resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				relations, ok := resp["relations"].([]interface{})
+				if !ok || len(relations) != 0 {
+					t.Errorf("expected empty relations array, got %v", resp["relations"])
+				}
+			},
+		},
+		{
+			name:           "Object type not in permission model",
+			url:            "/my-permissions?v=1&object=widget:abc",
+			authHeader:     "Bearer valid-token",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Object with relation",
+			url:            "/my-permissions?v=1&object=project:abc%23writer",
+			authHeader:     "Bearer valid-token",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid token",
+			url:            "/my-permissions?v=1&object=project:abc",
+			authHeader:     "Bearer invalid-token",
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newTestServer(t, &MockMessagingRepository{})
+			defer ts.Close()
+
+			reqCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, ts.URL+tt.url, nil)
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("failed to make request: %v", err)
+			}
+			defer func() {
+				if err := resp.Body.Close(); err != nil {
+					t.Errorf("failed to close response body: %v", err)
+				}
+			}()
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+
+			if tt.validateBody != nil && resp.StatusCode == http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					t.Fatalf("failed to read response body: %v", err)
+				}
+				tt.validateBody(t, body)
+			}
+		})
+	}
+}
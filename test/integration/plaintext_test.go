@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/linuxfoundation/lfx-v2-access-check/internal/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,33 +18,35 @@ func TestPlainTextFormatIntegration(t *testing.T) {
 
 		// Parse as the service would
 		lines := strings.Split(requestBody, "\n")
-		var validRequests []string
+		var validRequests []domain.Tuple
 
 		for _, line := range lines {
 			line = strings.TrimSpace(line)
 			if line != "" {
-				validRequests = append(validRequests, line)
+				check, err := domain.ParseObjectRelation(line)
+				assert.NoError(t, err, "request %q should match type:id#relation", line)
+				validRequests = append(validRequests, check)
 			}
 		}
 
 		// Verify parsing
 		assert.Len(t, validRequests, 3, "Should parse 3 valid requests")
-		assert.Equal(t, "project:test-project#view", validRequests[0])
-		assert.Equal(t, "project:test-project#edit", validRequests[1])
-		assert.Equal(t, "project:test-project#delete", validRequests[2])
+		assert.Equal(t, domain.Tuple{Object: "project:test-project", Relation: "view"}, validRequests[0])
+		assert.Equal(t, domain.Tuple{Object: "project:test-project", Relation: "edit"}, validRequests[1])
+		assert.Equal(t, domain.Tuple{Object: "project:test-project", Relation: "delete"}, validRequests[2])
 	})
 
 	t.Run("NATS message format construction", func(t *testing.T) {
-		// Test the format that would be sent to NATS: resource@user:principal
-		resource := "project:test-project#view"
-		user := "test-user"
-		principal := "user:test-user"
+		// Test the format that is sent to NATS: object#relation@user:principal
+		check, err := domain.ParseObjectRelation("project:test-project#view")
+		assert.NoError(t, err)
+		user, err := domain.NewUser("test-user")
+		assert.NoError(t, err)
 
-		// This matches the format used in AccessService.performAccessCheck
-		natsMessage := resource + "@" + user + ":" + principal
-		expectedFormat := "project:test-project#view@test-user:user:test-user"
+		natsMessage := check.WithUser(user).String()
+		expectedFormat := "project:test-project#view@user:test-user"
 
-		assert.Equal(t, expectedFormat, natsMessage, "NATS message should follow resource@user:principal format")
+		assert.Equal(t, expectedFormat, natsMessage, "NATS message should follow object#relation@user:principal format")
 	})
 
 	t.Run("plain text response format", func(t *testing.T) {
@@ -52,20 +55,24 @@ func TestPlainTextFormatIntegration(t *testing.T) {
 
 		// Parse as the service would
 		results := strings.Split(natsResponse, "\n")
-		var validResults []string
+		var validResults []domain.CheckResult
 
 		for _, result := range results {
 			result = strings.TrimSpace(result)
 			if result != "" {
-				validResults = append(validResults, result)
+				parsed, err := domain.ParseCheckResult(result)
+				assert.NoError(t, err, "result %q should parse", result)
+				validResults = append(validResults, parsed)
 			}
 		}
 
 		// Verify response parsing
 		assert.Len(t, validResults, 3, "Should parse 3 results")
-		assert.Contains(t, validResults[0], "\ttrue")
-		assert.Contains(t, validResults[1], "\tfalse")
-		assert.Contains(t, validResults[2], "\ttrue")
+		assert.True(t, validResults[0].Allowed)
+		assert.False(t, validResults[1].Allowed)
+		assert.True(t, validResults[2].Allowed)
+		assert.Equal(t, "user:auth0|alice", validResults[1].User)
+		assert.Equal(t, "committee:b3c72e18-1a2b-4c3d-8e9f-123456789abc", validResults[1].Object)
 	})
 }
 